@@ -0,0 +1,226 @@
+package main
+
+import (
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/internal/capture"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const usage = `usage:
+  capture compress [-compression gzip] [-block-size bytes] <capture file or data directory> <output file>
+  capture decompress <capture file or data directory> <output file>`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+
+	flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	options := capture.Options{Index: true}
+	switch os.Args[1] {
+	case "compress":
+		compression := flags.String("compression", "gzip", "codec to compress blocks of records with")
+		blockSize := flags.Int("block-size", capture.DefaultBlockSize, "size in bytes of the records in each compressed block")
+		_ = flags.Parse(os.Args[2:])
+		err := options.Compression.UnmarshalText([]byte(*compression))
+		if err != nil || options.Compression == capture.CompressionNone {
+			fmt.Println(fmt.Sprintf("unknown compression %q", *compression))
+			os.Exit(2)
+		}
+		options.BlockSize = *blockSize
+	case "decompress":
+		_ = flags.Parse(os.Args[2:])
+	default:
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+	if flags.NArg() != 2 {
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+
+	err := convert(flags.Arg(0), flags.Arg(1), options)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// source records to convert, read in the order they were received
+type source interface {
+	Next() (capture.Record, error)
+}
+
+// convert write every record of a capture, or a directory of packets saved by older listeners,
+// into a new capture at output with the given options
+func convert(input string, output string, options capture.Options) error {
+	info, err := os.Stat(input)
+	if err != nil {
+		return fmt.Errorf("failed to open input: %v", err)
+	}
+
+	var src source
+	var packetFormat uint16
+	if info.IsDir() {
+		dir, err := openDataDir(input)
+		if err != nil {
+			return err
+		}
+		src = dir
+		packetFormat = dir.packetFormat
+		options.Created = dir.created
+	} else {
+		file, err := os.Open(input)
+		if err != nil {
+			return fmt.Errorf("failed to open input: %v", err)
+		}
+		defer file.Close()
+
+		reader, err := capture.NewReader(file)
+		if err != nil {
+			return fmt.Errorf("failed to read capture: %v", err)
+		}
+		src = reader
+		packetFormat = reader.Header().PacketFormat
+		options.Created = reader.Header().Created
+	}
+
+	// Never replace an existing capture, the output may well be the only copy of a session
+	file, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create output: %v", err)
+	}
+	defer file.Close()
+
+	err = copyRecords(file, src, packetFormat, options)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(output)
+		return err
+	}
+
+	outputInfo, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat output: %v", err)
+	}
+	fmt.Println(fmt.Sprintf("Wrote %v bytes to %v", outputInfo.Size(), output))
+	return nil
+}
+
+// copyRecords write every record from the source into a new capture
+func copyRecords(w io.Writer, src source, packetFormat uint16, options capture.Options) error {
+	writer, err := capture.NewWriter(w, packetFormat, options)
+	if err != nil {
+		return fmt.Errorf("failed to create capture: %v", err)
+	}
+
+	count := 0
+	for {
+		record, err := src.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read record %v: %v", count, err)
+		}
+
+		err = writer.Write(record)
+		if err != nil {
+			return fmt.Errorf("failed to write record %v: %v", count, err)
+		}
+		count++
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close capture: %v", err)
+	}
+	fmt.Println(fmt.Sprintf("Converted %v records", count))
+	return nil
+}
+
+// dataDir a directory of packets saved by older listeners, one <unix nano timestamp>.data file per packet
+type dataDir struct {
+	dir          string
+	files        []dataFile
+	next         int
+	packetFormat uint16
+	created      time.Time
+}
+
+type dataFile struct {
+	name     string
+	received time.Time
+}
+
+// openDataDir list the packets in a data directory, in the order they were received
+func openDataDir(dir string) (*dataDir, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data directory: %v", err)
+	}
+
+	d := &dataDir{dir: dir}
+	for _, info := range infos {
+		if info.IsDir() || filepath.Ext(info.Name()) != ".data" {
+			continue
+		}
+		timestamp, err := strconv.ParseInt(strings.TrimSuffix(info.Name(), ".data"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected data file name %v: %v", info.Name(), err)
+		}
+		d.files = append(d.files, dataFile{
+			name:     info.Name(),
+			received: time.Unix(0, timestamp),
+		})
+	}
+	if len(d.files) == 0 {
+		return nil, fmt.Errorf("no data files found in %v", dir)
+	}
+	sort.SliceStable(d.files, func(i, j int) bool {
+		return d.files[i].received.Before(d.files[j].received)
+	})
+	d.created = d.files[0].received
+
+	// The packet format is always the first field of the header, read from the first packet which has one
+	for _, f := range d.files {
+		data, err := ioutil.ReadFile(filepath.Join(dir, f.name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read data file: %v", err)
+		}
+		if len(data) >= 2 {
+			d.packetFormat = binary.LittleEndian.Uint16(data)
+			break
+		}
+	}
+	return d, nil
+}
+
+// Next read the next packet, returning io.EOF once all have been read
+func (d *dataDir) Next() (capture.Record, error) {
+	if d.next >= len(d.files) {
+		return capture.Record{}, io.EOF
+	}
+	f := d.files[d.next]
+	d.next++
+
+	data, err := ioutil.ReadFile(filepath.Join(d.dir, f.name))
+	if err != nil {
+		return capture.Record{}, fmt.Errorf("failed to read data file: %v", err)
+	}
+	return capture.Record{
+		Received: f.received,
+		Data:     data,
+	}, nil
+}
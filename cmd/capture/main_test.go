@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/roryphillips/f1-telemetry-client/internal/capture"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// packet a packet of the 2021 format, told apart by its last byte
+func packet(n int) []byte {
+	data := make([]byte, 30)
+	binary.LittleEndian.PutUint16(data, 2021)
+	data[len(data)-1] = byte(n)
+	return data
+}
+
+// readCapture every record of a capture file
+func readCapture(t *testing.T, fileName string) (capture.FileHeader, []capture.Record) {
+	t.Helper()
+	file, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("failed to open %v: %v", fileName, err)
+	}
+	defer file.Close()
+	reader, err := capture.NewReader(file)
+	if err != nil {
+		t.Fatalf("failed to read %v: %v", fileName, err)
+	}
+	var records []capture.Record
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			return reader.Header(), records
+		}
+		if err != nil {
+			t.Fatalf("failed to read record %v of %v: %v", len(records), fileName, err)
+		}
+		records = append(records, record)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	dir := t.TempDir()
+	data := filepath.Join(dir, "data", "1626616985000000000")
+	err := os.MkdirAll(data, os.ModePerm)
+	if err != nil {
+		t.Fatalf("failed to create data directory: %v", err)
+	}
+
+	// Timestamps which sort differently as names than as numbers, and an empty packet first
+	// so the packet format has to be read from a later one
+	received := []int64{999999999, 1000000000, 1000000001, 1626616985000000000, 1626616985000000001}
+	want := make([][]byte, len(received))
+	for i, timestamp := range received {
+		want[i] = packet(i)
+		if i == 0 {
+			want[i] = []byte{}
+		}
+		err := ioutil.WriteFile(filepath.Join(data, strconv.FormatInt(timestamp, 10)+".data"), want[i], 0644)
+		if err != nil {
+			t.Fatalf("failed to write data file: %v", err)
+		}
+	}
+	// Anything other than data files is left alone
+	err = ioutil.WriteFile(filepath.Join(data, "notes.txt"), []byte("not a packet"), 0644)
+	if err != nil {
+		t.Fatalf("failed to write notes: %v", err)
+	}
+
+	check := func(fileName string, compression capture.Compression) {
+		t.Helper()
+		header, records := readCapture(t, fileName)
+		if header.PacketFormat != 2021 {
+			t.Errorf("%v: packet format %v, want 2021", fileName, header.PacketFormat)
+		}
+		if header.Compression != compression {
+			t.Errorf("%v: compression %v, want %v", fileName, header.Compression, compression)
+		}
+		if !header.Created.Equal(time.Unix(0, received[0])) {
+			t.Errorf("%v: created %v, want the first packet's time %v", fileName, header.Created, time.Unix(0, received[0]))
+		}
+		if len(records) != len(want) {
+			t.Fatalf("%v: %v records, want %v", fileName, len(records), len(want))
+		}
+		for i, record := range records {
+			if !record.Received.Equal(time.Unix(0, received[i])) {
+				t.Errorf("%v: record %v received %v, want %v", fileName, i, record.Received.UnixNano(), received[i])
+			}
+			if !bytes.Equal(record.Data, want[i]) {
+				t.Errorf("%v: record %v is %x, want %x", fileName, i, record.Data, want[i])
+			}
+		}
+	}
+
+	compressed := filepath.Join(dir, "compressed"+capture.Extension)
+	err = convert(data, compressed, capture.Options{Index: true, Compression: capture.CompressionGzip})
+	if err != nil {
+		t.Fatalf("failed to compress data directory: %v", err)
+	}
+	check(compressed, capture.CompressionGzip)
+
+	decompressed := filepath.Join(dir, "decompressed"+capture.Extension)
+	err = convert(compressed, decompressed, capture.Options{Index: true})
+	if err != nil {
+		t.Fatalf("failed to decompress capture: %v", err)
+	}
+	check(decompressed, capture.CompressionNone)
+
+	// An existing capture is never replaced
+	before, err := ioutil.ReadFile(compressed)
+	if err != nil {
+		t.Fatalf("failed to read capture: %v", err)
+	}
+	err = convert(decompressed, compressed, capture.Options{Index: true, Compression: capture.CompressionGzip})
+	if err == nil {
+		t.Errorf("converted into an existing capture")
+	}
+	after, err := ioutil.ReadFile(compressed)
+	if err != nil || !bytes.Equal(before, after) {
+		t.Errorf("converting into an existing capture changed it")
+	}
+
+	// A directory without data files
+	err = convert(t.TempDir(), filepath.Join(dir, "empty"+capture.Extension), capture.Options{})
+	if err == nil {
+		t.Errorf("converted a directory without data files")
+	}
+}
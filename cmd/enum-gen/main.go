@@ -0,0 +1,327 @@
+// enum-gen generates String, MarshalText, UnmarshalText, MarshalJSON and UnmarshalJSON methods
+// for every integer enum in a package, naming each value after its constant in snake case
+// with the type name prefix removed, i.e. WeatherTypeHeavyRain is "heavy_rain".
+//
+// Bit flag types, which have a Has method, are not enums and are skipped.
+//
+// Run from within a package directory, usually via:
+//
+//	//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/enum-gen
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	output := flag.String("output", "enums_gen.go", "file to write the generated methods to")
+	flag.Parse()
+
+	err := generate(".", *output)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+// generate write the enum methods for the package in dir into the output file
+func generate(dir string, output string) error {
+	src, err := methods(dir, output)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, output), src, 0644)
+}
+
+// methods the source of the enum methods for the package in dir, ignoring the previously generated output file
+func methods(dir string, output string) ([]byte, error) {
+	importPath, err := goList(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve import path: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return info.Name() != filepath.Base(output) && !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse package: %v", err)
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected a single package in %v, found %v", dir, len(pkgs))
+	}
+
+	var files []*ast.File
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			files = append(files, f)
+		}
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		// The package may refer to the methods being generated, so type errors are tolerated
+		// as long as the constant declarations themselves can be resolved
+		Error: func(err error) {},
+	}
+	pkg, _ := conf.Check(importPath, fset, files, nil)
+	if pkg == nil {
+		return nil, fmt.Errorf("failed to type check package %v", importPath)
+	}
+
+	enums, err := collectEnums(pkg)
+	if err != nil {
+		return nil, err
+	}
+	if len(enums) == 0 {
+		return nil, fmt.Errorf("no enums found in package %v", importPath)
+	}
+
+	g := &generator{pkg: pkg}
+	for _, e := range enums {
+		g.generateEnum(e)
+	}
+
+	src, err := format.Source(g.file())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %v", err)
+	}
+	return src, nil
+}
+
+// goList resolve the import path of the package in dir
+func goList(dir string) (string, error) {
+	cmd := exec.Command("go", "list", "-f", "{{.ImportPath}}", ".")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+type enum struct {
+	named  *types.Named
+	values []enumValue
+}
+
+type enumValue struct {
+	name  string
+	value string
+}
+
+// collectEnums find every integer type in the package with constants, in declaration order
+func collectEnums(pkg *types.Package) ([]*enum, error) {
+	var consts []*types.Const
+	for _, name := range pkg.Scope().Names() {
+		c, ok := pkg.Scope().Lookup(name).(*types.Const)
+		if !ok {
+			continue
+		}
+		consts = append(consts, c)
+	}
+	sort.Slice(consts, func(i, j int) bool {
+		return consts[i].Pos() < consts[j].Pos()
+	})
+
+	var enums []*enum
+	byType := make(map[*types.Named]*enum)
+	for _, c := range consts {
+		named, ok := c.Type().(*types.Named)
+		if !ok || named.Obj().Pkg() != pkg || !isEnum(named) {
+			continue
+		}
+
+		e, ok := byType[named]
+		if !ok {
+			e = &enum{named: named}
+			byType[named] = e
+			enums = append(enums, e)
+		}
+
+		name := snakeCase(strings.TrimPrefix(c.Name(), named.Obj().Name()))
+		// A bare number would be mistaken for the value itself, i.e. Sector1 is "sector_1" rather than "1"
+		if strings.Trim(name, "0123456789") == "" {
+			name = snakeCase(c.Name())
+		}
+		for _, existing := range e.values {
+			if existing.name == name {
+				return nil, fmt.Errorf("%v has more than one value named %v", named.Obj().Name(), name)
+			}
+		}
+		e.values = append(e.values, enumValue{
+			name:  name,
+			value: c.Val().ExactString(),
+		})
+	}
+
+	sort.SliceStable(enums, func(i, j int) bool {
+		return enums[i].named.Obj().Pos() < enums[j].named.Obj().Pos()
+	})
+	return enums, nil
+}
+
+// isEnum whether the type is an integer which isn't a set of bit flags
+func isEnum(named *types.Named) bool {
+	basic, ok := named.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsInteger == 0 {
+		return false
+	}
+	has, _, _ := types.LookupFieldOrMethod(named, true, named.Obj().Pkg(), "Has")
+	return has == nil
+}
+
+// snakeCase convert a CamelCase name into snake case, splitting words and trailing numbers
+// i.e. HeavyRain is heavy_rain, Qualifying2 is qualifying_2, F1Modern is f1_modern and 2D is 2d
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var out []rune
+	for i, r := range runes {
+		if i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			switch {
+			case unicode.IsUpper(r) && unicode.IsLower(prev):
+				out = append(out, '_')
+			case unicode.IsUpper(r) && unicode.IsDigit(prev) && nextLower:
+				out = append(out, '_')
+			case unicode.IsUpper(r) && unicode.IsUpper(prev) && nextLower:
+				out = append(out, '_')
+			case unicode.IsDigit(r) && unicode.IsLower(prev):
+				out = append(out, '_')
+			}
+		}
+		out = append(out, unicode.ToLower(r))
+	}
+	return string(out)
+}
+
+type generator struct {
+	pkg  *types.Package
+	body bytes.Buffer
+}
+
+// file the complete generated source file
+func (g *generator) file() []byte {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by enum-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %v\n\n", g.pkg.Name())
+	fmt.Fprintf(&out, "import (\n")
+	for _, path := range []string{
+		"encoding/json",
+		"fmt",
+		"strconv",
+	} {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	fmt.Fprintf(&out, ")\n")
+
+	out.Write(g.body.Bytes())
+	return out.Bytes()
+}
+
+// generateEnum write the name lookups and methods for an enum
+func (g *generator) generateEnum(e *enum) {
+	name := e.named.Obj().Name()
+	basic := e.named.Underlying().(*types.Basic)
+	unsigned := basic.Info()&types.IsUnsigned != 0
+	bits := 64
+	switch basic.Kind() {
+	case types.Int8, types.Uint8:
+		bits = 8
+	case types.Int16, types.Uint16:
+		bits = 16
+	case types.Int32, types.Uint32:
+		bits = 32
+	}
+	format := "strconv.FormatInt(int64(v), 10)"
+	parse := fmt.Sprintf("strconv.ParseInt(string(text), 10, %v)", bits)
+	if unsigned {
+		format = "strconv.FormatUint(uint64(v), 10)"
+		parse = fmt.Sprintf("strconv.ParseUint(string(text), 10, %v)", bits)
+	}
+	names := lowerFirst(name) + "Names"
+	values := lowerFirst(name) + "Values"
+
+	w := &g.body
+	fmt.Fprintf(w, "\nvar %v = map[%v]string{\n", names, name)
+	seen := make(map[string]bool)
+	for _, v := range e.values {
+		// The first constant declared for a value names it
+		if seen[v.value] {
+			continue
+		}
+		seen[v.value] = true
+		fmt.Fprintf(w, "%v: %q,\n", v.value, v.name)
+	}
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\nvar %v = map[string]%v{\n", values, name)
+	for _, v := range e.values {
+		fmt.Fprintf(w, "%q: %v,\n", v.name, v.value)
+	}
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\n// String name of the %v, or the type and number for unknown values\n", name)
+	fmt.Fprintf(w, "func (v %v) String() string {\n", name)
+	fmt.Fprintf(w, "if name, ok := %v[v]; ok {\nreturn name\n}\n", names)
+	fmt.Fprintf(w, "return fmt.Sprintf(\"%v(%%d)\", v)\n", name)
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\n// MarshalText name of the %v, or the number for unknown values\n", name)
+	fmt.Fprintf(w, "func (v %v) MarshalText() ([]byte, error) {\n", name)
+	fmt.Fprintf(w, "if name, ok := %v[v]; ok {\nreturn []byte(name), nil\n}\n", names)
+	fmt.Fprintf(w, "return []byte(%v), nil\n", format)
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\n// UnmarshalText set the %v from its name or number\n", name)
+	fmt.Fprintf(w, "func (v *%v) UnmarshalText(text []byte) error {\n", name)
+	fmt.Fprintf(w, "if val, ok := %v[string(text)]; ok {\n*v = val\nreturn nil\n}\n", values)
+	fmt.Fprintf(w, "n, err := %v\n", parse)
+	fmt.Fprintf(w, "if err != nil {\nreturn fmt.Errorf(\"unknown %v %%q\", text)\n}\n", name)
+	fmt.Fprintf(w, "*v = %v(n)\nreturn nil\n", name)
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\n// MarshalJSON name of the %v as a string, or its number for unknown values\n", name)
+	fmt.Fprintf(w, "func (v %v) MarshalJSON() ([]byte, error) {\n", name)
+	fmt.Fprintf(w, "if name, ok := %v[v]; ok {\nreturn json.Marshal(name)\n}\n", names)
+	fmt.Fprintf(w, "return []byte(%v), nil\n", format)
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\n// UnmarshalJSON set the %v from its name as a string, or its number\n", name)
+	fmt.Fprintf(w, "func (v *%v) UnmarshalJSON(data []byte) error {\n", name)
+	fmt.Fprintf(w, "var text string\n")
+	fmt.Fprintf(w, "if err := json.Unmarshal(data, &text); err == nil {\nreturn v.UnmarshalText([]byte(text))\n}\n")
+	fmt.Fprintf(w, "return v.UnmarshalText(data)\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+// lowerFirst lower case the first letter of a name, i.e. for an unexported variable
+func lowerFirst(name string) string {
+	runes := []rune(name)
+	// Leading acronyms are lowered as a whole, i.e. MFDPanel is mfdPanel
+	i := 0
+	for i < len(runes) && unicode.IsUpper(runes[i]) {
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+		i++
+	}
+	return string(runes)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerated every generated enum file in the repository is what the generator produces now
+func TestGenerated(t *testing.T) {
+	var files []string
+	for _, pattern := range []string{"../../packets/*/enums_gen.go", "../../internal/*/enums_gen.go"} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			t.Fatalf("failed to find generated enums: %v", err)
+		}
+		files = append(files, matches...)
+	}
+	if len(files) < 8 {
+		t.Fatalf("found only %v generated enum files", len(files))
+	}
+
+	for _, file := range files {
+		dir := filepath.Dir(file)
+		want, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %v: %v", file, err)
+		}
+		got, err := methods(dir, filepath.Base(file))
+		if err != nil {
+			t.Errorf("%v: failed to generate enums: %v", dir, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%v: generated enums differ from %v, run go generate", dir, filepath.Base(file))
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type conditions struct {
+		Weather session.WeatherType `json:"weather"`
+		Track   session.TrackType   `json:"track"`
+	}
+	tests := []struct {
+		value conditions
+		want  string
+	}{
+		{conditions{session.WeatherTypeHeavyRain, session.TrackTypeSilverstone}, `{"weather":"heavy_rain","track":"silverstone"}`},
+		{conditions{session.WeatherTypeClear, session.TrackTypeUnknown}, `{"weather":"clear","track":"unknown"}`},
+		// Unknown values are bare numbers, signed or not, rather than numbers in strings
+		{conditions{session.WeatherType(9), session.TrackType(-7)}, `{"weather":9,"track":-7}`},
+		{conditions{session.WeatherType(255), session.TrackType(127)}, `{"weather":255,"track":127}`},
+	}
+
+	for _, test := range tests {
+		got, err := json.Marshal(test.value)
+		if err != nil {
+			t.Errorf("failed to marshal %+v: %v", test.value, err)
+			continue
+		}
+		if string(got) != test.want {
+			t.Errorf("marshalled %+v as %s, want %s", test.value, got, test.want)
+		}
+
+		var decoded conditions
+		err = json.Unmarshal(got, &decoded)
+		if err != nil {
+			t.Errorf("failed to unmarshal %s: %v", got, err)
+			continue
+		}
+		if decoded != test.value {
+			t.Errorf("unmarshalled %s as %+v, want %+v", got, decoded, test.value)
+		}
+	}
+
+	// Numbers in strings, as written before unknown values were bare numbers, can still be read
+	var weather session.WeatherType
+	err := json.Unmarshal([]byte(`"9"`), &weather)
+	if err != nil || weather != 9 {
+		t.Errorf("unmarshalled \"9\" as %v, %v", weather, err)
+	}
+}
@@ -0,0 +1,640 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/internal/capture"
+	"github.com/roryphillips/f1-telemetry-client/internal/receiver"
+	"github.com/roryphillips/f1-telemetry-client/internal/stats"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+	"github.com/roryphillips/f1-telemetry-client/registry"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/template"
+	"time"
+)
+
+const (
+	// PACKET_BUFFER_KB default buffer size in kilobytes to read packets into
+	PACKET_BUFFER_KB = 2
+	// MAX_PACKET_BUFFER largest buffer size in bytes, the largest UDP datagram
+	MAX_PACKET_BUFFER = 65535
+	// MAX_QUEUE_SIZE most packets which can be waiting to be saved
+	MAX_QUEUE_SIZE = 1 << 20
+)
+
+// config how the listener receives and saves packets, read from flags and an optional JSON config file
+type config struct {
+	// Address IP address or host name to receive packets on, 0.0.0.0 to receive from other machines
+	Address string `json:"address"`
+	// Port UDP port the game sends telemetry to
+	Port int `json:"port"`
+	// OutputDir directory captures are saved to
+	OutputDir string `json:"output_dir"`
+	// BufferSize size in bytes of the buffer packets are read into, packets which fill it are discarded as truncated
+	BufferSize int `json:"buffer_size"`
+	// QueueSize number of packets which can be waiting to be saved before new packets are dropped
+	QueueSize int `json:"queue_size"`
+	// Format compression captures are written with
+	Format capture.Compression `json:"format"`
+	// SessionName template for the capture file name, the capture extension is added if missing
+	SessionName string `json:"session_name"`
+	// SplitSessions start a new capture whenever the session changes, i.e. from qualifying to the race
+	SplitSessions bool `json:"split_sessions"`
+	// MinDuration seconds a capture must cover to be kept, shorter captures are deleted once closed
+	MinDuration int `json:"min_duration"`
+	// StatsInterval seconds between printing statistics on the packets received, 0 to only print them when stopping
+	StatsInterval int `json:"stats_interval"`
+	// StatsAddress address to serve statistics as JSON from at /stats, i.e. 127.0.0.1:8080, empty to not serve them
+	StatsAddress string `json:"stats_address"`
+}
+
+// sessionName the values a session name template can use, i.e. {{.Time.Format "2006-01-02_15-04"}}
+type sessionName struct {
+	// Time when the capture was started
+	Time time.Time
+	// Unix when the capture was started in seconds since the epoch
+	Unix int64
+	// PacketFormat game the packets were sent by, i.e. 2021
+	PacketFormat uint16
+	// SessionUID unique identifier for the session, 0 when sessions aren't split
+	SessionUID uint64
+	// SessionType type of session, i.e. qualifying_1 or race_1, unknown without a session packet
+	SessionType string
+	// Track track the session is at, i.e. silverstone, unknown without a session packet
+	Track string
+}
+
+// defaultConfig receive packets sent to the local machine and save them to ./data
+func defaultConfig() config {
+	return config{
+		Address:       "127.0.0.1",
+		Port:          20777,
+		OutputDir:     "./data",
+		BufferSize:    1024 * PACKET_BUFFER_KB,
+		QueueSize:     1024,
+		Format:        capture.CompressionGzip,
+		SessionName:   "{{.Unix}}_{{.SessionType}}_{{.Track}}",
+		SplitSessions: true,
+		StatsInterval: 10,
+	}
+}
+
+// compressionFlag sets a capture compression from its name
+type compressionFlag struct {
+	compression *capture.Compression
+}
+
+func (f compressionFlag) String() string {
+	if f.compression == nil {
+		return ""
+	}
+	return f.compression.String()
+}
+
+func (f compressionFlag) Set(value string) error {
+	return f.compression.UnmarshalText([]byte(value))
+}
+
+// loadConfig read the config from the command line arguments, and the config file if one is given.
+// Flags take precedence over the config file, which takes precedence over the defaults.
+func loadConfig(args []string) (config, error) {
+	cfg := defaultConfig()
+
+	flags := flag.NewFlagSet("listener", flag.ContinueOnError)
+	configFile := flags.String("config", "", "JSON file to read the config from, i.e. {\"address\": \"0.0.0.0\", \"port\": 20778}")
+	flags.StringVar(&cfg.Address, "address", cfg.Address, "IP address or host name to receive packets on, 0.0.0.0 to receive from other machines")
+	flags.IntVar(&cfg.Port, "port", cfg.Port, "UDP port the game sends telemetry to")
+	flags.StringVar(&cfg.OutputDir, "output", cfg.OutputDir, "directory to save captures to")
+	flags.IntVar(&cfg.BufferSize, "buffer-size", cfg.BufferSize, "size in bytes of the buffer packets are read into")
+	flags.IntVar(&cfg.QueueSize, "queue-size", cfg.QueueSize, "number of packets waiting to be saved before new packets are dropped")
+	flags.Var(compressionFlag{&cfg.Format}, "format", "compression to write captures with: none, gzip or zstd")
+	flags.StringVar(&cfg.SessionName, "session-name", cfg.SessionName, "template for capture file names, using .Time, .Unix, .PacketFormat, .SessionUID, .SessionType and .Track")
+	flags.BoolVar(&cfg.SplitSessions, "split-sessions", cfg.SplitSessions, "start a new capture whenever the session changes")
+	flags.IntVar(&cfg.MinDuration, "min-duration", cfg.MinDuration, "seconds a capture must cover to be kept, shorter captures are deleted")
+	flags.IntVar(&cfg.StatsInterval, "stats-interval", cfg.StatsInterval, "seconds between printing packet statistics, 0 to only print them when stopping")
+	flags.StringVar(&cfg.StatsAddress, "stats-address", cfg.StatsAddress, "address to serve packet statistics as JSON from at /stats, i.e. 127.0.0.1:8080")
+	err := flags.Parse(args)
+	if err != nil {
+		return cfg, err
+	}
+	if flags.NArg() != 0 {
+		return cfg, fmt.Errorf("unexpected arguments %v", flags.Args())
+	}
+
+	if *configFile != "" {
+		data, err := ioutil.ReadFile(*configFile)
+		if err != nil {
+			return cfg, fmt.Errorf("failed to read config file: %v", err)
+		}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&cfg)
+		if err != nil {
+			return cfg, fmt.Errorf("failed to parse config file %v: %v", *configFile, err)
+		}
+		// Parse the flags again so they override the config file, they were valid the first time
+		_ = flags.Parse(args)
+	}
+
+	return cfg, cfg.validate()
+}
+
+// validate check the config can be used to listen for packets
+func (c config) validate() error {
+	if c.Address == "" {
+		return fmt.Errorf("address must be set, use 0.0.0.0 to receive from every interface")
+	}
+	if c.Port < 1 || c.Port > 65535 {
+		return fmt.Errorf("port %v must be between 1 and 65535", c.Port)
+	}
+	_, err := net.ResolveUDPAddr("udp", c.listenAddress())
+	if err != nil {
+		return fmt.Errorf("invalid address %v: %v", c.Address, err)
+	}
+	if c.OutputDir == "" {
+		return fmt.Errorf("output directory must be set")
+	}
+	if c.BufferSize <= receiver.LargestPacket || c.BufferSize > MAX_PACKET_BUFFER {
+		return fmt.Errorf("buffer size %v must be between %v and %v", c.BufferSize, receiver.LargestPacket+1, MAX_PACKET_BUFFER)
+	}
+	if c.QueueSize < 1 || c.QueueSize > MAX_QUEUE_SIZE {
+		return fmt.Errorf("queue size %v must be between 1 and %v", c.QueueSize, MAX_QUEUE_SIZE)
+	}
+	if !capture.HasCodec(c.Format) {
+		return fmt.Errorf("format %v is not supported", c.Format)
+	}
+	if c.MinDuration < 0 {
+		return fmt.Errorf("minimum duration %v must not be negative", c.MinDuration)
+	}
+	if c.StatsInterval < 0 {
+		return fmt.Errorf("stats interval %v must not be negative", c.StatsInterval)
+	}
+	if c.StatsAddress != "" {
+		_, err = net.ResolveTCPAddr("tcp", c.StatsAddress)
+		if err != nil {
+			return fmt.Errorf("invalid stats address %v: %v", c.StatsAddress, err)
+		}
+	}
+	_, err = c.fileName(sessionName{
+		Time:         time.Now(),
+		PacketFormat: 2021,
+		SessionType:  session.SessionTypeRace1.String(),
+		Track:        session.TrackTypeSilverstone.String(),
+	})
+	return err
+}
+
+// listenAddress address and port to listen on
+func (c config) listenAddress() string {
+	return net.JoinHostPort(c.Address, strconv.Itoa(c.Port))
+}
+
+// fileName path of the capture for a session, from the session name template
+func (c config) fileName(name sessionName) (string, error) {
+	tmpl, err := template.New("session-name").Option("missingkey=error").Parse(c.SessionName)
+	if err != nil {
+		return "", fmt.Errorf("invalid session name %q: %v", c.SessionName, err)
+	}
+	name.Unix = name.Time.Unix()
+	var out strings.Builder
+	err = tmpl.Execute(&out, name)
+	if err != nil {
+		return "", fmt.Errorf("invalid session name %q: %v", c.SessionName, err)
+	}
+
+	base := out.String()
+	if base == "" || base == "." || base == ".." || strings.ContainsAny(base, `/\`) {
+		return "", fmt.Errorf("session name %q must be a file name, not %q", c.SessionName, base)
+	}
+	if filepath.Ext(base) != capture.Extension {
+		base += capture.Extension
+	}
+	return filepath.Join(c.OutputDir, base), nil
+}
+
+// summary what the listener received and saved, printed once it stops
+type summary struct {
+	started   time.Time
+	stopped   time.Time
+	captures  int
+	discarded int
+	// unidentified packets dropped because no packet with a readable header arrived to identify their session
+	unidentified int
+	records      int
+	received     int64
+	saved        int64
+	// final statistics on the packets received once the listener stopped
+	final stats.Snapshot
+}
+
+func (s summary) String() string {
+	var out strings.Builder
+	if s.records == 0 {
+		out.WriteString("No packets were saved")
+	} else {
+		fmt.Fprintf(&out, "Saved %v packets to %v captures over %v, %v bytes received and %v bytes written",
+			s.records, s.captures, s.stopped.Sub(s.started).Round(time.Second), s.received, s.saved)
+	}
+	if s.discarded > 0 {
+		fmt.Fprintf(&out, "\n%v captures were discarded as too short", s.discarded)
+	}
+	if s.unidentified > 0 {
+		fmt.Fprintf(&out, "\n%v packets without a readable header were dropped, as no session was identified", s.unidentified)
+	}
+	fmt.Fprintf(&out, "\n%v", s.final)
+	return out.String()
+}
+
+func main() {
+	cfg, err := loadConfig(os.Args[1:])
+	if err == flag.ErrHelp {
+		os.Exit(0)
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		fmt.Println(fmt.Sprintf("Received %v, saving capture", sig))
+		cancel()
+		// A second signal gives up on saving the capture
+		<-signals
+		os.Exit(1)
+	}()
+
+	err = listenForData(ctx, cfg)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// listenForData save every packet received to a capture until the context is cancelled
+// or the connection fails, then save any pending packets and close the capture
+func listenForData(ctx context.Context, cfg config) error {
+	fmt.Println("Creating data export directory")
+	err := os.MkdirAll(cfg.OutputDir, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("failed to create data export directory: %v", err)
+	}
+
+	conn, err := net.ListenPacket("udp", cfg.listenAddress())
+	if err != nil {
+		return fmt.Errorf("failed to listen: %v", err)
+	}
+	defer conn.Close()
+	fmt.Println(fmt.Sprintf("Listening on %v", conn.LocalAddr()))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	rec, err := receiver.New(conn, receiver.Options{
+		BufferSize: cfg.BufferSize,
+		QueueSize:  cfg.QueueSize,
+	})
+	if err != nil {
+		return err
+	}
+	collector := stats.New(rec)
+	if cfg.StatsAddress != "" {
+		stop, err := serveStats(cfg.StatsAddress, collector)
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
+	if cfg.StatsInterval > 0 {
+		go printStats(ctx, collector, time.Duration(cfg.StatsInterval)*time.Second)
+	}
+
+	readErr := make(chan error, 1)
+	go func() {
+		readErr <- rec.Run(ctx)
+	}()
+
+	saved := summary{started: time.Now()}
+	err = saveData(cfg, rec, collector, &saved)
+	if err != nil {
+		// Stop reading, there's nowhere to save the packets
+		cancel()
+		<-readErr
+		return err
+	}
+	saved.stopped = time.Now()
+	saved.final = collector.Snapshot()
+	fmt.Println(saved)
+	return <-readErr
+}
+
+// printStats print the packet statistics every interval until the context is cancelled
+func printStats(ctx context.Context, collector *stats.Collector, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			fmt.Println(collector.Snapshot())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// serveStats serve the packet statistics as JSON from /stats on the address, returning a func to stop serving
+func serveStats(address string, collector *stats.Collector) (func(), error) {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serve stats: %v", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/stats", collector)
+	server := &http.Server{Handler: mux}
+	go func() {
+		_ = server.Serve(l)
+	}()
+	fmt.Println(fmt.Sprintf("Serving stats from http://%v/stats", l.Addr()))
+	return func() {
+		_ = server.Close()
+	}, nil
+}
+
+const (
+	// MAX_PENDING_PACKETS most packets held back waiting for the session packet which names their capture
+	MAX_PENDING_PACKETS = 4096
+	// MAX_PENDING_TIME longest packets are held back waiting for the session packet which names their capture
+	MAX_PENDING_TIME = 5 * time.Second
+)
+
+// sessionCapture the capture of the packets from a single session
+type sessionCapture struct {
+	name sessionName
+	// identified whether the packet format and session have been set from a packet's header
+	identified bool
+	// named whether the name has been set from a session packet
+	named    bool
+	fileName string
+	file     *os.File
+	writer   *capture.Writer
+	// pending packets received before the capture was created, released once written
+	pending  []capture.Record
+	first    time.Time
+	last     time.Time
+	records  int
+	received int64
+}
+
+// saveData append every packet received to a capture per session, or a single capture when sessions
+// aren't split. Each capture is created once its session packet has been received, so it can be named
+// after the session type and track. Every capture is closed, writing its index, once the receiver
+// has stopped and its queue is empty.
+func saveData(cfg config, rec *receiver.Receiver, collector *stats.Collector, saved *summary) error {
+	var current *sessionCapture
+	// Flushing ends a compressed block, so flush periodically rather than after every burst of packets
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	defer func() {
+		// Only reached with a capture still open if saving failed, so the capture is abandoned
+		if current != nil && current.file != nil {
+			_ = current.file.Close()
+		}
+	}()
+
+	for {
+		select {
+		case record, ok := <-rec.Records():
+			if !ok {
+				if current == nil {
+					return nil
+				}
+				err := closeSession(cfg, rec, current, saved)
+				current = nil
+				return err
+			}
+
+			// Every packet is decoded once, for the statistics and to name sessions after their session packet
+			header, packet, decodeErr := registry.Decode(record.Data)
+			collector.Observe(record, header, decodeErr)
+
+			// Packets without a readable header are kept with the current session, or held with the next
+			// session until a packet with a header identifies it
+			headerErr := errors.Is(decodeErr, registry.ErrInvalidHeader) || errors.Is(decodeErr, registry.ErrUnsupportedFormat)
+			if !headerErr && current != nil && !current.identified {
+				identifySession(cfg, current, header)
+			}
+			if !headerErr && current != nil && cfg.SplitSessions && header.SessionUID != current.name.SessionUID {
+				err := closeSession(cfg, rec, current, saved)
+				current = nil
+				if err != nil {
+					return err
+				}
+			}
+			if current == nil {
+				current = &sessionCapture{
+					name: sessionName{
+						Time:        record.Received,
+						SessionType: "unknown",
+						Track:       "unknown",
+					},
+					first: record.Received,
+				}
+				if !headerErr {
+					identifySession(cfg, current, header)
+				}
+			}
+			if !current.identified && (len(current.pending)+1 >= MAX_PENDING_PACKETS || record.Received.Sub(current.first) >= MAX_PENDING_TIME) {
+				// Nothing has identified the session these packets belong to, so there's no capture to save them to
+				saved.unidentified += len(current.pending) + 1
+				releasePending(rec, current)
+				rec.Release(record)
+				current = nil
+				continue
+			}
+
+			if current.writer == nil && decodeErr == nil && header.PacketID == common.PacketIDSession {
+				nameSession(current, packet)
+			}
+			err := writeSession(cfg, rec, current, record, saved)
+			if err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if current == nil || current.writer == nil {
+				continue
+			}
+			err := current.writer.Flush()
+			if err != nil {
+				return fmt.Errorf("failed to write data: %v", err)
+			}
+		}
+	}
+}
+
+// identifySession set the packet format and session of a capture from the header of one of its packets
+func identifySession(cfg config, current *sessionCapture, header common.Header) {
+	current.identified = true
+	current.name.PacketFormat = header.PacketFormat
+	if cfg.SplitSessions {
+		current.name.SessionUID = header.SessionUID
+	}
+}
+
+// releasePending release the packets held back for a session which will never be written
+func releasePending(rec *receiver.Receiver, current *sessionCapture) {
+	for _, record := range current.pending {
+		rec.Release(record)
+	}
+	current.pending = nil
+}
+
+// nameSession name a session after the session type and track of its decoded session packet
+func nameSession(current *sessionCapture, packet interface{}) {
+	current.named = true
+	switch p := packet.(type) {
+	case *session.Packet2019:
+		current.name.SessionType, current.name.Track = p.Session.String(), p.Track.String()
+	case *session.Packet:
+		current.name.SessionType, current.name.Track = p.Session.String(), p.Track.String()
+	case *session.Packet2021:
+		current.name.SessionType, current.name.Track = p.Session.String(), p.Track.String()
+	case *session.Packet2022:
+		current.name.SessionType, current.name.Track = p.Session.String(), p.Track.String()
+	default:
+		current.named = false
+	}
+}
+
+// writeSession append a packet to the session's capture, holding packets back until the capture can be named
+func writeSession(cfg config, rec *receiver.Receiver, current *sessionCapture, record capture.Record, saved *summary) error {
+	current.last = record.Received
+	current.records++
+	current.received += int64(len(record.Data))
+
+	if current.writer == nil {
+		current.pending = append(current.pending, record)
+		if !current.named && len(current.pending) < MAX_PENDING_PACKETS && record.Received.Sub(current.first) < MAX_PENDING_TIME {
+			return nil
+		}
+		return openSession(cfg, rec, current)
+	}
+
+	err := current.writer.Write(record)
+	rec.Release(record)
+	if err != nil {
+		return fmt.Errorf("failed to write data: %v", err)
+	}
+	return nil
+}
+
+// openSession create the capture for a session and write the packets held back until now
+func openSession(cfg config, rec *receiver.Receiver, current *sessionCapture) error {
+	fileName, err := cfg.fileName(current.name)
+	if err != nil {
+		return err
+	}
+	// Never replace an earlier capture, i.e. of another session started in the same second
+	base := strings.TrimSuffix(fileName, capture.Extension)
+	for n := 2; ; n++ {
+		current.file, err = os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if !os.IsExist(err) {
+			break
+		}
+		fileName = fmt.Sprintf("%v_%v%v", base, n, capture.Extension)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create file: %v", err)
+	}
+	current.fileName = fileName
+
+	current.writer, err = capture.NewWriter(current.file, current.name.PacketFormat, capture.Options{
+		Index:       true,
+		Compression: cfg.Format,
+		Created:     current.first,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create capture: %v", err)
+	}
+	fmt.Println(fmt.Sprintf("Saving data to %v", fileName))
+
+	pending := current.pending
+	current.pending = nil
+	for i, record := range pending {
+		err = current.writer.Write(record)
+		rec.Release(record)
+		if err != nil {
+			for _, record := range pending[i+1:] {
+				rec.Release(record)
+			}
+			return fmt.Errorf("failed to write data: %v", err)
+		}
+	}
+	return nil
+}
+
+// closeSession close the session's capture, writing its index, or delete it if it's too short to keep
+func closeSession(cfg config, rec *receiver.Receiver, current *sessionCapture, saved *summary) error {
+	if !current.identified {
+		// Only packets without a readable header were received, so there's no capture to save them to
+		saved.unidentified += len(current.pending)
+		releasePending(rec, current)
+		return nil
+	}
+
+	duration := current.last.Sub(current.first)
+	if duration < time.Duration(cfg.MinDuration)*time.Second {
+		releasePending(rec, current)
+		saved.discarded++
+		fmt.Println(fmt.Sprintf("Discarding session %v, it's only %v long", current.name.SessionUID, duration.Round(time.Second)))
+		if current.file == nil {
+			return nil
+		}
+		_ = current.file.Close()
+		current.file = nil
+		return os.Remove(current.fileName)
+	}
+
+	if current.writer == nil {
+		err := openSession(cfg, rec, current)
+		if err != nil {
+			return err
+		}
+	}
+	err := current.writer.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close capture: %v", err)
+	}
+	info, err := current.file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat capture: %v", err)
+	}
+	err = current.file.Close()
+	current.file = nil
+	if err != nil {
+		return fmt.Errorf("failed to close file: %v", err)
+	}
+
+	saved.captures++
+	saved.records += current.records
+	saved.received += current.received
+	saved.saved += info.Size()
+	fmt.Println(fmt.Sprintf("Saved %v packets over %v to %v", current.records, duration.Round(time.Second), current.fileName))
+	return nil
+}
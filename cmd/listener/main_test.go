@@ -0,0 +1,419 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"github.com/roryphillips/f1-telemetry-client/internal/capture"
+	"github.com/roryphillips/f1-telemetry-client/internal/receiver"
+	"github.com/roryphillips/f1-telemetry-client/internal/stats"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/motion"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+	"github.com/roryphillips/f1-telemetry-client/registry"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// configFile write a config file into a temporary directory
+func configFile(t *testing.T, contents string) string {
+	t.Helper()
+	fileName := filepath.Join(t.TempDir(), "listener.json")
+	err := ioutil.WriteFile(fileName, []byte(contents), 0644)
+	if err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	return fileName
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := loadConfig(nil)
+	if err != nil || cfg != defaultConfig() {
+		t.Errorf("without arguments loaded %+v, %v, want the defaults", cfg, err)
+	}
+
+	fileName := configFile(t, `{"address": "0.0.0.0", "port": 20778, "queue_size": 10, "format": "none", "min_duration": 30}`)
+	tests := []struct {
+		name string
+		args []string
+		// want the config loaded, starting from the defaults
+		want func(cfg *config)
+	}{
+		{
+			name: "flags",
+			args: []string{"-port", "20779", "-format", "none", "-split-sessions=false"},
+			want: func(cfg *config) {
+				cfg.Port = 20779
+				cfg.Format = capture.CompressionNone
+				cfg.SplitSessions = false
+			},
+		},
+		{
+			name: "config file",
+			args: []string{"-config", fileName},
+			want: func(cfg *config) {
+				cfg.Address = "0.0.0.0"
+				cfg.Port = 20778
+				cfg.QueueSize = 10
+				cfg.Format = capture.CompressionNone
+				cfg.MinDuration = 30
+			},
+		},
+		{
+			name: "flags override the config file",
+			args: []string{"-port", "20779", "-config", fileName, "-min-duration", "0", "-format", "gzip"},
+			want: func(cfg *config) {
+				cfg.Address = "0.0.0.0"
+				cfg.Port = 20779
+				cfg.QueueSize = 10
+				cfg.Format = capture.CompressionGzip
+				cfg.MinDuration = 0
+			},
+		},
+	}
+	for _, test := range tests {
+		cfg, err := loadConfig(test.args)
+		if err != nil {
+			t.Errorf("%v: failed to load config: %v", test.name, err)
+			continue
+		}
+		want := defaultConfig()
+		test.want(&want)
+		if cfg != want {
+			t.Errorf("%v: loaded %+v, want %+v", test.name, cfg, want)
+		}
+	}
+
+	// Asking for help isn't an error, so main can exit successfully
+	_, err = loadConfig([]string{"-h"})
+	if err != flag.ErrHelp {
+		t.Errorf("-h returned %v, want %v", err, flag.ErrHelp)
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"unknown flag", []string{"-colour", "red"}},
+		{"bad flag value", []string{"-port", "twenty"}},
+		{"unknown format", []string{"-format", "bzip2"}},
+		{"arguments", []string{"-port", "20778", "data"}},
+		{"missing config file", []string{"-config", filepath.Join(t.TempDir(), "missing.json")}},
+		{"unknown config field", []string{"-config", configFile(t, `{"prot": 20778}`)}},
+		{"config file isn't JSON", []string{"-config", configFile(t, `port = 20778`)}},
+		{"invalid config file value", []string{"-config", configFile(t, `{"port": 0}`)}},
+		{"invalid flag over the config file", []string{"-config", configFile(t, `{"port": 20778}`), "-port", "70000"}},
+	}
+	for _, test := range tests {
+		_, err := loadConfig(test.args)
+		if err == nil {
+			t.Errorf("%v: loaded config from %q", test.name, test.args)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		// change a valid config to the one to test
+		change func(cfg *config)
+		valid  bool
+	}{
+		{"defaults", func(cfg *config) {}, true},
+		{"every interface", func(cfg *config) { cfg.Address = "0.0.0.0" }, true},
+		{"IPv6", func(cfg *config) { cfg.Address = "::1" }, true},
+		{"no address", func(cfg *config) { cfg.Address = "" }, false},
+		{"bad address", func(cfg *config) { cfg.Address = "[::1" }, false},
+		{"port 0", func(cfg *config) { cfg.Port = 0 }, false},
+		{"negative port", func(cfg *config) { cfg.Port = -1 }, false},
+		{"port too large", func(cfg *config) { cfg.Port = 65536 }, false},
+		{"largest port", func(cfg *config) { cfg.Port = 65535 }, true},
+		{"no output directory", func(cfg *config) { cfg.OutputDir = "" }, false},
+		{"buffer the size of a packet", func(cfg *config) { cfg.BufferSize = 1464 }, false},
+		{"buffer larger than a datagram", func(cfg *config) { cfg.BufferSize = MAX_PACKET_BUFFER + 1 }, false},
+		{"no queue", func(cfg *config) { cfg.QueueSize = 0 }, false},
+		{"unknown format", func(cfg *config) { cfg.Format = capture.Compression(99) }, false},
+		{"negative minimum duration", func(cfg *config) { cfg.MinDuration = -1 }, false},
+		{"negative stats interval", func(cfg *config) { cfg.StatsInterval = -10 }, false},
+		{"stats address", func(cfg *config) { cfg.StatsAddress = "127.0.0.1:8080" }, true},
+		{"stats address without a port", func(cfg *config) { cfg.StatsAddress = "127.0.0.1" }, false},
+		{"session name with a format", func(cfg *config) { cfg.SessionName = `{{.Time.Format "2006-01-02"}}_{{.PacketFormat}}` }, true},
+		{"session name which doesn't parse", func(cfg *config) { cfg.SessionName = "{{.Unix" }, false},
+		{"session name with an unknown field", func(cfg *config) { cfg.SessionName = "{{.Driver}}" }, false},
+		{"session name with a directory", func(cfg *config) { cfg.SessionName = "sessions/{{.Unix}}" }, false},
+		{"empty session name", func(cfg *config) { cfg.SessionName = "" }, false},
+		{"parent directory session name", func(cfg *config) { cfg.SessionName = ".." }, false},
+	}
+	for _, test := range tests {
+		cfg := defaultConfig()
+		test.change(&cfg)
+		err := cfg.validate()
+		if (err == nil) != test.valid {
+			t.Errorf("%v: validate returned %v, want valid %v", test.name, err, test.valid)
+		}
+	}
+}
+
+// encode a packet of the 2021 format from a session
+func encode(t *testing.T, id common.PacketID, sessionUID uint64, packet interface{}) []byte {
+	t.Helper()
+	header := common.Header{PacketFormat: 2021, PacketVersion: 1, PacketID: id, SessionUID: sessionUID}
+	data, err := registry.Encode(header, packet)
+	if err != nil {
+		t.Fatalf("failed to encode packet: %v", err)
+	}
+	return data
+}
+
+// sessionPacket a session packet naming a session
+func sessionPacket(t *testing.T, sessionUID uint64, sessionType session.SessionType, track session.TrackType) []byte {
+	return encode(t, common.PacketIDSession, sessionUID, &session.Packet2021{Session: sessionType, Track: track})
+}
+
+// motionPacket a motion packet from a session, told apart from others by the first car's position
+func motionPacket(t *testing.T, sessionUID uint64, n int) []byte {
+	packet := &motion.Packet{}
+	packet.CarMotion[0].WorldPosition.X = float32(n)
+	return encode(t, common.PacketIDMotion, sessionUID, packet)
+}
+
+// receive the packets with a stopped receiver, so every packet is waiting in its closed queue
+func receive(t *testing.T, packets [][]byte) *receiver.Receiver {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer conn.Close()
+	rec, err := receiver.New(conn, receiver.Options{BufferSize: 2048, QueueSize: len(packets) + 1})
+	if err != nil {
+		t.Fatalf("failed to create receiver: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- rec.Run(ctx)
+	}()
+
+	out, err := net.Dial("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer out.Close()
+	for i, data := range packets {
+		_, err := out.Write(data)
+		if err != nil {
+			t.Fatalf("failed to send packet %v: %v", i, err)
+		}
+	}
+	for deadline := time.Now().Add(5 * time.Second); rec.Stats().Received < uint64(len(packets)); {
+		if time.Now().After(deadline) {
+			t.Fatalf("received %v of %v packets", rec.Stats().Received, len(packets))
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	err = <-runErr
+	if err != nil {
+		t.Fatalf("receiver failed: %v", err)
+	}
+	return rec
+}
+
+// readRecords the data of every record in a capture file
+func readRecords(t *testing.T, fileName string) [][]byte {
+	t.Helper()
+	file, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("failed to open capture: %v", err)
+	}
+	defer file.Close()
+	reader, err := capture.NewReader(file)
+	if err != nil {
+		t.Fatalf("failed to read capture %v: %v", fileName, err)
+	}
+	var records [][]byte
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			return records
+		}
+		if err != nil {
+			t.Fatalf("failed to read record %v of %v: %v", len(records), fileName, err)
+		}
+		records = append(records, record.Data)
+	}
+}
+
+// captureNames the names of the files in a directory
+func captureNames(t *testing.T, dir string) []string {
+	t.Helper()
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to list captures: %v", err)
+	}
+	var names []string
+	for _, file := range files {
+		names = append(names, file.Name())
+	}
+	return names
+}
+
+// testConfig a config saving uncompressed captures named after their session to a temporary directory
+func testConfig(t *testing.T) config {
+	cfg := defaultConfig()
+	cfg.OutputDir = t.TempDir()
+	cfg.Format = capture.CompressionNone
+	cfg.SessionName = "{{.SessionUID}}_{{.SessionType}}_{{.Track}}"
+	return cfg
+}
+
+func TestSaveDataSplitsSessions(t *testing.T) {
+	cfg := testConfig(t)
+	race := "1_" + session.SessionTypeRace1.String() + "_" + session.TrackTypeSilverstone.String()
+	qualifying := "2_" + session.SessionTypeQualifying1.String() + "_" + session.TrackTypeMonza.String()
+	// An earlier capture with the same name is never replaced
+	earlier := filepath.Join(cfg.OutputDir, race+capture.Extension)
+	err := ioutil.WriteFile(earlier, []byte("earlier capture"), 0644)
+	if err != nil {
+		t.Fatalf("failed to write earlier capture: %v", err)
+	}
+
+	// Packets without a header are held until the session is known, then kept with whichever session is current
+	noHeader := []byte{1, 2, 3}
+	packets := [][]byte{
+		noHeader,
+		motionPacket(t, 1, 1),
+		sessionPacket(t, 1, session.SessionTypeRace1, session.TrackTypeSilverstone),
+		motionPacket(t, 1, 2),
+		noHeader,
+		sessionPacket(t, 2, session.SessionTypeQualifying1, session.TrackTypeMonza),
+		motionPacket(t, 2, 3),
+	}
+	rec := receive(t, packets)
+	saved := summary{}
+	err = saveData(cfg, rec, stats.New(rec), &saved)
+	if err != nil {
+		t.Fatalf("failed to save data: %v", err)
+	}
+
+	names := captureNames(t, cfg.OutputDir)
+	want := []string{race + capture.Extension, race + "_2" + capture.Extension, qualifying + capture.Extension}
+	if !reflect.DeepEqual(names, want) {
+		t.Fatalf("saved captures %v, want %v", names, want)
+	}
+	data, err := ioutil.ReadFile(earlier)
+	if err != nil || string(data) != "earlier capture" {
+		t.Errorf("earlier capture was replaced with %q, %v", data, err)
+	}
+	for _, c := range []struct {
+		name    string
+		packets [][]byte
+	}{
+		{race + "_2" + capture.Extension, packets[:5]},
+		{qualifying + capture.Extension, packets[5:]},
+	} {
+		records := readRecords(t, filepath.Join(cfg.OutputDir, c.name))
+		if !reflect.DeepEqual(records, c.packets) {
+			t.Errorf("%v holds %v packets %x, want %v packets %x", c.name, len(records), records, len(c.packets), c.packets)
+		}
+	}
+	if saved.captures != 2 || saved.records != len(packets) || saved.discarded != 0 || saved.unidentified != 0 {
+		t.Errorf("saved %v captures of %v packets, discarded %v and dropped %v unidentified packets",
+			saved.captures, saved.records, saved.discarded, saved.unidentified)
+	}
+}
+
+func TestSaveDataWithoutHeaders(t *testing.T) {
+	cfg := testConfig(t)
+	rec := receive(t, [][]byte{{1}, {2, 3}, {4, 5, 6}})
+	saved := summary{}
+	err := saveData(cfg, rec, stats.New(rec), &saved)
+	if err != nil {
+		t.Fatalf("failed to save data: %v", err)
+	}
+	if names := captureNames(t, cfg.OutputDir); len(names) != 0 {
+		t.Errorf("saved captures %v for packets without a session", names)
+	}
+	if saved.captures != 0 || saved.unidentified != 3 {
+		t.Errorf("saved %v captures and dropped %v unidentified packets, want 0 and 3", saved.captures, saved.unidentified)
+	}
+}
+
+func TestCloseSession(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer conn.Close()
+	rec, err := receiver.New(conn, receiver.Options{BufferSize: 2048, QueueSize: 1})
+	if err != nil {
+		t.Fatalf("failed to create receiver: %v", err)
+	}
+
+	started := time.Unix(1626616985, 0)
+	tests := []struct {
+		name     string
+		duration time.Duration
+		// named whether the session was named, so its capture was created rather than its packets held back
+		named bool
+		kept  bool
+	}{
+		{"short and pending", 10 * time.Second, false, false},
+		{"short and named", 29 * time.Second, true, false},
+		{"long enough and pending", 30 * time.Second, false, true},
+		{"long enough and named", time.Minute, true, true},
+	}
+	for i, test := range tests {
+		cfg := testConfig(t)
+		cfg.MinDuration = 30
+		current := &sessionCapture{
+			name:       sessionName{Time: started, PacketFormat: 2021, SessionUID: uint64(i), SessionType: "race_1", Track: "monza"},
+			identified: true,
+			named:      test.named,
+			first:      started,
+		}
+		for _, received := range []time.Time{started, started.Add(test.duration)} {
+			record := capture.Record{Received: received, Data: motionPacket(t, uint64(i), i)}
+			if test.named {
+				err := writeSession(cfg, rec, current, record, &summary{})
+				if err != nil {
+					t.Fatalf("%v: failed to write packet: %v", test.name, err)
+				}
+				continue
+			}
+			current.pending = append(current.pending, record)
+			current.last = received
+			current.records++
+		}
+		if test.named == (current.writer == nil) {
+			t.Fatalf("%v: capture created %v, want %v", test.name, current.writer != nil, test.named)
+		}
+
+		saved := summary{}
+		err := closeSession(cfg, rec, current, &saved)
+		if err != nil {
+			t.Errorf("%v: failed to close session: %v", test.name, err)
+			continue
+		}
+		names := captureNames(t, cfg.OutputDir)
+		if test.kept {
+			if len(names) != 1 || saved.captures != 1 || saved.records != 2 || saved.discarded != 0 {
+				t.Errorf("%v: saved %v captures of %v packets to %v, discarded %v, want it kept", test.name, saved.captures, saved.records, names, saved.discarded)
+			}
+		} else if len(names) != 0 || saved.captures != 0 || saved.discarded != 1 {
+			t.Errorf("%v: saved %v captures to %v, discarded %v, want it discarded", test.name, saved.captures, names, saved.discarded)
+		}
+		if current.pending != nil {
+			t.Errorf("%v: %v packets are still pending", test.name, len(current.pending))
+		}
+	}
+}
@@ -0,0 +1,374 @@
+// packet-decoder-gen generates reflection free DecodeFrom methods for every struct in a package
+// which has packet tags, reading the same layout as packets.PacketParser.
+//
+// Run from within a package directory, usually via:
+//
+//	//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	output := flag.String("output", "decode_gen.go", "file to write the generated decoders to")
+	flag.Parse()
+
+	err := generate(".", *output)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+// generate write the decoders for the package in dir into the output file
+func generate(dir string, output string) error {
+	src, err := decoders(dir, output)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, output), src, 0644)
+}
+
+// decoders the source of the decoders for the package in dir, ignoring the previously generated output file
+func decoders(dir string, output string) ([]byte, error) {
+	importPath, err := goList(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve import path: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return info.Name() != filepath.Base(output) && !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse package: %v", err)
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected a single package in %v, found %v", dir, len(pkgs))
+	}
+
+	var files []*ast.File
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			files = append(files, f)
+		}
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		// The package may refer to the decoders being generated, so type errors are tolerated
+		// as long as the struct declarations themselves can be resolved
+		Error: func(err error) {},
+	}
+	pkg, _ := conf.Check(importPath, fset, files, nil)
+	if pkg == nil {
+		return nil, fmt.Errorf("failed to type check package %v", importPath)
+	}
+
+	g := &generator{
+		pkg:     pkg,
+		imports: map[string]string{"fmt": "fmt"},
+	}
+	for _, name := range pkg.Scope().Names() {
+		obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok {
+			continue
+		}
+		if _, ok := named.Underlying().(*types.Struct); !ok {
+			continue
+		}
+		err = g.generateStruct(named)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate %v: %v", name, err)
+		}
+	}
+
+	src, err := format.Source(g.file())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %v", err)
+	}
+	return src, nil
+}
+
+// goList resolve the import path of the package in dir
+func goList(dir string) (string, error) {
+	cmd := exec.Command("go", "list", "-f", "{{.ImportPath}}", ".")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+type generator struct {
+	pkg     *types.Package
+	imports map[string]string
+	body    bytes.Buffer
+}
+
+type packetField struct {
+	packetIdx int
+	name      string
+	t         types.Type
+	length    int
+}
+
+// file the complete generated source file
+func (g *generator) file() []byte {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by packet-decoder-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %v\n\n", g.pkg.Name())
+
+	var paths []string
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	fmt.Fprintf(&out, "import (\n")
+	for _, path := range paths {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	fmt.Fprintf(&out, ")\n")
+
+	out.Write(g.body.Bytes())
+	return out.Bytes()
+}
+
+// qualifier name types from other packages by their package name, recording the import
+func (g *generator) qualifier(pkg *types.Package) string {
+	if pkg == g.pkg {
+		return ""
+	}
+	g.imports[pkg.Path()] = pkg.Name()
+	return pkg.Name()
+}
+
+// typeName name of the type as written within the generated package
+func (g *generator) typeName(t types.Type) string {
+	return types.TypeString(t, g.qualifier)
+}
+
+// sortedFields the packet fields of a struct in packet order, as generateSortedFields does
+func sortedFields(st *types.Struct) ([]packetField, error) {
+	var fields []packetField
+	for i := 0; i < st.NumFields(); i++ {
+		tag := reflect.StructTag(st.Tag(i))
+		packetIdx := tag.Get("packet")
+		if packetIdx == "" {
+			continue
+		}
+		pInt, err := strconv.ParseInt(packetIdx, 10, 32)
+		if err != nil {
+			return fields, fmt.Errorf("invalid packet index received: %v", err)
+		}
+		field := packetField{
+			packetIdx: int(pInt),
+			name:      st.Field(i).Name(),
+			t:         st.Field(i).Type(),
+		}
+		length := tag.Get("length")
+		if length != "" {
+			lInt, err := strconv.ParseInt(length, 10, 32)
+			if err != nil {
+				return fields, fmt.Errorf("invalid length received: %v", err)
+			}
+			field.length = int(lInt)
+		}
+		fields = append(fields, field)
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].packetIdx < fields[j].packetIdx
+	})
+	return fields, nil
+}
+
+// sizeOf size of a type on the wire in bytes
+func (g *generator) sizeOf(t types.Type, length int) (int, error) {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch u.Kind() {
+		case types.Bool, types.Uint8, types.Int8:
+			return 1, nil
+		case types.Uint16, types.Int16:
+			return 2, nil
+		case types.Uint32, types.Int32, types.Float32:
+			return 4, nil
+		case types.Uint64, types.Int64, types.Float64:
+			return 8, nil
+		case types.String:
+			if length <= 0 {
+				return 0, fmt.Errorf("string fields must specify a length")
+			}
+			return length, nil
+		}
+	case *types.Array:
+		size, err := g.sizeOf(u.Elem(), length)
+		return int(u.Len()) * size, err
+	case *types.Struct:
+		fields, err := sortedFields(u)
+		if err != nil {
+			return 0, err
+		}
+		total := 0
+		for _, field := range fields {
+			size, err := g.sizeOf(field.t, field.length)
+			if err != nil {
+				return 0, fmt.Errorf("unable to size %v: %v", field.name, err)
+			}
+			total += size
+		}
+		return total, nil
+	}
+	return 0, fmt.Errorf("unsupported type %v", t)
+}
+
+// generateStruct write the decoder methods for a struct with packet tags
+func (g *generator) generateStruct(named *types.Named) error {
+	fields, err := sortedFields(named.Underlying().(*types.Struct))
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	size, err := g.sizeOf(named, 0)
+	if err != nil {
+		return err
+	}
+
+	name := named.Obj().Name()
+	w := &g.body
+	fmt.Fprintf(w, "\n// DecodeFrom decode %v from its wire format, returning the number of bytes read\n", name)
+	fmt.Fprintf(w, "func (p *%v) DecodeFrom(data []byte) (int, error) {\n", name)
+	fmt.Fprintf(w, "if len(data) < %v {\n", size)
+	fmt.Fprintf(w, "return 0, fmt.Errorf(\"unable to decode %v: need %v bytes, have %%v\", len(data))\n", name, size)
+	fmt.Fprintf(w, "}\n")
+	fmt.Fprintf(w, "if err := p.decode(data); err != nil {\nreturn 0, err\n}\n")
+	fmt.Fprintf(w, "return %v, nil\n", size)
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\n// PacketSize size of %v on the wire in bytes\n", name)
+	fmt.Fprintf(w, "func (p *%v) PacketSize() int {\n", name)
+	fmt.Fprintf(w, "return %v\n", size)
+	fmt.Fprintf(w, "}\n")
+
+	fmt.Fprintf(w, "\n// decode decode %v from data holding at least %v bytes\n", name, size)
+	fmt.Fprintf(w, "func (p *%v) decode(data []byte) error {\n", name)
+	offset := 0
+	for _, field := range fields {
+		err = g.emitValue("p."+field.name, field.name, field.t, field.length, strconv.Itoa(offset), 0)
+		if err != nil {
+			return fmt.Errorf("unable to generate %v: %v", field.name, err)
+		}
+		fieldSize, err := g.sizeOf(field.t, field.length)
+		if err != nil {
+			return err
+		}
+		offset += fieldSize
+	}
+	fmt.Fprintf(w, "return nil\n")
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
+
+// emitValue write the statements decoding a single value at the given offset expression into target
+func (g *generator) emitValue(target string, name string, t types.Type, length int, offset string, depth int) error {
+	w := &g.body
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		conv := g.typeName(t)
+		switch u.Kind() {
+		case types.Bool:
+			fmt.Fprintf(w, "if data[%v] > 1 {\n", offset)
+			fmt.Fprintf(w, "return fmt.Errorf(\"failed to set %v value: unexpected byte value %%v is <0 or >1\", data[%v])\n", name, offset)
+			fmt.Fprintf(w, "}\n")
+			fmt.Fprintf(w, "%v = %v(data[%v] == 1)\n", target, conv, offset)
+		case types.Uint8, types.Int8:
+			fmt.Fprintf(w, "%v = %v(data[%v])\n", target, conv, offset)
+		case types.Uint16, types.Int16:
+			g.imports["encoding/binary"] = "binary"
+			fmt.Fprintf(w, "%v = %v(binary.LittleEndian.Uint16(data[%v:]))\n", target, conv, offset)
+		case types.Uint32, types.Int32:
+			g.imports["encoding/binary"] = "binary"
+			fmt.Fprintf(w, "%v = %v(binary.LittleEndian.Uint32(data[%v:]))\n", target, conv, offset)
+		case types.Uint64, types.Int64:
+			g.imports["encoding/binary"] = "binary"
+			fmt.Fprintf(w, "%v = %v(binary.LittleEndian.Uint64(data[%v:]))\n", target, conv, offset)
+		case types.Float32:
+			g.imports["encoding/binary"] = "binary"
+			g.imports["math"] = "math"
+			fmt.Fprintf(w, "%v = %v(math.Float32frombits(binary.LittleEndian.Uint32(data[%v:])))\n", target, conv, offset)
+		case types.Float64:
+			g.imports["encoding/binary"] = "binary"
+			g.imports["math"] = "math"
+			fmt.Fprintf(w, "%v = %v(math.Float64frombits(binary.LittleEndian.Uint64(data[%v:])))\n", target, conv, offset)
+		case types.String:
+			if length <= 0 {
+				return fmt.Errorf("string fields must specify a length")
+			}
+			g.imports["bytes"] = "bytes"
+			str := fmt.Sprintf("data[%v : %v+%v]", offset, offset, length)
+			fmt.Fprintf(w, "if end := bytes.IndexByte(%v, 0); end >= 0 {\n", str)
+			fmt.Fprintf(w, "%v = %v(%v[:end])\n", target, conv, str)
+			fmt.Fprintf(w, "} else {\n")
+			fmt.Fprintf(w, "%v = %v(%v)\n", target, conv, str)
+			fmt.Fprintf(w, "}\n")
+		default:
+			return fmt.Errorf("unsupported type %v", t)
+		}
+		return nil
+	case *types.Array:
+		elemSize, err := g.sizeOf(u.Elem(), length)
+		if err != nil {
+			return err
+		}
+		idx := fmt.Sprintf("i%v", depth)
+		fmt.Fprintf(w, "for %v := range %v {\n", idx, target)
+		err = g.emitValue(
+			fmt.Sprintf("%v[%v]", target, idx),
+			fmt.Sprintf("%v item", name),
+			u.Elem(),
+			length,
+			fmt.Sprintf("%v+%v*%v", offset, idx, elemSize),
+			depth+1,
+		)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "}\n")
+		return nil
+	case *types.Struct:
+		named, ok := t.(*types.Named)
+		if !ok || named.Obj().Pkg() != g.pkg {
+			return fmt.Errorf("nested struct %v must be declared in package %v", t, g.pkg.Name())
+		}
+		fmt.Fprintf(w, "if err := %v.decode(data[%v:]); err != nil {\n", target, offset)
+		fmt.Fprintf(w, "return fmt.Errorf(\"unable to set struct field %v: %%v\", err)\n", name)
+		fmt.Fprintf(w, "}\n")
+		return nil
+	}
+	return fmt.Errorf("unsupported type %v", t)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerated every generated decoder in the repository is what the generator produces now,
+// including the nested and primitive arrays of packets/internal/arrays
+func TestGenerated(t *testing.T) {
+	files, err := filepath.Glob("../../packets/*/decode_gen.go")
+	if err != nil {
+		t.Fatalf("failed to find generated decoders: %v", err)
+	}
+	internal, err := filepath.Glob("../../packets/internal/*/decode_gen.go")
+	if err != nil {
+		t.Fatalf("failed to find generated decoders: %v", err)
+	}
+	files = append(files, internal...)
+	if len(internal) == 0 || len(files) < 13 {
+		t.Fatalf("found only %v generated decoders", len(files))
+	}
+
+	for _, file := range files {
+		dir := filepath.Dir(file)
+		want, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %v: %v", file, err)
+		}
+		got, err := decoders(dir, filepath.Base(file))
+		if err != nil {
+			t.Errorf("%v: failed to generate decoders: %v", dir, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%v: generated decoders differ from %v, run go generate", dir, filepath.Base(file))
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/internal/capture"
+	"github.com/roryphillips/f1-telemetry-client/internal/enum"
+	"github.com/roryphillips/f1-telemetry-client/internal/lookup"
+	"github.com/roryphillips/f1-telemetry-client/registry"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func main() {
+	numericEnums := flag.Bool("numeric-enums", false, "write enums as numbers rather than names, matching older output")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Println("usage: reader [-numeric-enums] <capture file>")
+		os.Exit(2)
+	}
+
+	hand := handler{registry: registry.Default, numericEnums: *numericEnums}
+	err := hand.demo(flag.Arg(0))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+		return
+	}
+	os.Exit(0)
+}
+
+// errUndecodable a packet of a registered type couldn't be decoded, i.e. its layout doesn't match
+var errUndecodable = errors.New("failed to decode packet")
+
+type handler struct {
+	registry registry.Registry
+	// numericEnums write enums as their numbers rather than their names
+	numericEnums bool
+}
+
+// demo convert every packet in a capture into JSON, writing a file per packet type
+// with a packet per line in the order they were received
+func (h *handler) demo(fileName string) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return fmt.Errorf("failed to open capture: %v", err)
+	}
+	defer file.Close()
+
+	reader, err := capture.NewReader(file)
+	if err != nil {
+		return fmt.Errorf("failed to read capture: %v", err)
+	}
+
+	outputDir := filepath.Join("output", strings.TrimSuffix(filepath.Base(fileName), capture.Extension))
+	err = os.MkdirAll(outputDir, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("failed to create output directory: %v", err)
+	}
+
+	outputs := make(map[string]*bufio.Writer)
+	files := make(map[string]*os.File)
+	// Only closes the files left open when returning early, once every record is written
+	// they're flushed and closed below so a failed write isn't lost
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+		}
+	}()
+
+	bytesWritten := 0
+	skipped := 0
+	startTime := time.Now()
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read record: %v", err)
+		}
+
+		t, data, err := h.convertToJSON(record.Data)
+		if errors.Is(err, errUndecodable) {
+			// One bad packet shouldn't lose the rest of the capture
+			skipped++
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse bytes: %v", err)
+		}
+		if len(data) == 0 || t == "" {
+			continue
+		}
+
+		output, ok := outputs[t]
+		if !ok {
+			f, err := os.Create(filepath.Join(outputDir, t+".json"))
+			if err != nil {
+				return fmt.Errorf("failed to create output file: %v", err)
+			}
+			output = bufio.NewWriter(f)
+			outputs[t] = output
+			files[t] = f
+		}
+
+		_, err = output.Write(append(data, '\n'))
+		if err != nil {
+			return fmt.Errorf("failed to write json: %v", err)
+		}
+		bytesWritten += len(data) + 1
+	}
+
+	var closeErr error
+	for t, output := range outputs {
+		err := output.Flush()
+		if err != nil {
+			err = fmt.Errorf("failed to write json: %v", err)
+		}
+		fileErr := files[t].Close()
+		if fileErr != nil && err == nil {
+			err = fmt.Errorf("failed to close output file: %v", fileErr)
+		}
+		delete(files, t)
+		if err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	dur := time.Now().Sub(startTime)
+	fmt.Println(fmt.Sprintf("Wrote %v bytes in %v seconds", bytesWritten, dur.Seconds()))
+	if skipped > 0 {
+		fmt.Println(fmt.Sprintf("Skipped %v packets which couldn't be decoded", skipped))
+	}
+	return nil
+}
+
+func (h *handler) convertToJSON(data []byte) (string, []byte, error) {
+	var t string
+	var out []byte
+
+	header, parsed, err := h.registry.Decode(data)
+	if errors.Is(err, registry.ErrUnregisteredPacket) {
+		return t, out, nil
+	}
+	if err != nil {
+		return t, out, fmt.Errorf("%w: %v", errUndecodable, err)
+	}
+
+	entry, _ := h.registry.Lookup(header)
+	t = entry.Name
+	value := parsed
+	if h.numericEnums {
+		value = enum.Numeric(parsed)
+	}
+	// Names are looked up from the decoded packet, as the numeric wrapper has none of its methods
+	if names, ok := lookup.NamesOf(header.PacketFormat, parsed); ok {
+		value = lookup.Annotated{Packet: value, Names: names}
+	}
+	out, err = json.Marshal(value)
+	if err != nil {
+		return t, out, fmt.Errorf("failed to marshal packet to json: %v", err)
+	}
+	return t, out, nil
+}
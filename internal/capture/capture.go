@@ -0,0 +1,56 @@
+package capture
+
+import (
+	"errors"
+	"time"
+)
+
+// Magic identifies a capture file, the first bytes of every capture
+var Magic = [4]byte{'F', '1', 'C', 'P'}
+
+// Version of the capture format written and read by this package
+const Version uint16 = 3
+
+// Extension file extension for capture files
+const Extension = ".f1cap"
+
+// ErrNotCapture returned when reading a file which doesn't start with the capture magic
+var ErrNotCapture = errors.New("not a capture file")
+
+// ErrUnsupportedVersion returned when reading a capture of any version of the format other than Version
+var ErrUnsupportedVersion = errors.New("unsupported capture version")
+
+// FileHeader written once at the start of a capture
+type FileHeader struct {
+	// Version of the capture format
+	Version uint16
+	// PacketFormat game the packets were sent by, i.e. 2020
+	PacketFormat uint16
+	// Created when the capture was started
+	Created time.Time
+	// Compression codec the records are compressed with
+	Compression Compression
+}
+
+// Record a single packet received by the listener
+type Record struct {
+	// Received when the packet was received
+	Received time.Time
+	// Data raw bytes of the packet
+	Data []byte
+}
+
+// fileHeaderSize size of the file header on disk: magic, version, packet format, created time and compression
+const fileHeaderSize = 4 + 2 + 2 + 8 + 2
+
+// recordHeaderSize size of the header before each record's data: length and received time
+const recordHeaderSize = 4 + 8
+
+// blockHeaderSize size of the header before each compressed block: compressed length
+const blockHeaderSize = 4
+
+// maxRecordSize largest record accepted when reading, well above the largest UDP datagram
+const maxRecordSize = 1 << 16
+
+// maxBlockSize largest compressed or decompressed block accepted when reading
+const maxBlockSize = 1 << 26
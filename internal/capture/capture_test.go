@@ -0,0 +1,265 @@
+package capture_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"github.com/roryphillips/f1-telemetry-client/internal/capture"
+	"io"
+	"testing"
+	"time"
+)
+
+// records a few records of different sizes, received at times down to the nanosecond
+func records() []capture.Record {
+	start := time.Date(2021, 7, 18, 14, 3, 5, 123456789, time.UTC)
+	var records []capture.Record
+	for i, size := range []int{1, 24, 1464, 0, 48, 65535, 7} {
+		data := make([]byte, size)
+		for j := range data {
+			data[j] = byte(i*31 + j)
+		}
+		records = append(records, capture.Record{
+			Received: start.Add(time.Duration(i) * 16666667 * time.Nanosecond),
+			Data:     data,
+		})
+	}
+	return records
+}
+
+// write a capture of the records to memory
+func write(t *testing.T, options capture.Options, records []capture.Record) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := capture.NewWriter(&buf, 2021, options)
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	for i, record := range records {
+		err := w.Write(record)
+		if err != nil {
+			t.Fatalf("failed to write record %v: %v", i, err)
+		}
+	}
+	err = w.Close()
+	if err != nil {
+		t.Fatalf("failed to close writer: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// readAll read every record of a capture until an error, returning the error unless it's io.EOF
+func readAll(r *capture.Reader) ([]capture.Record, error) {
+	var records []capture.Record
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return records, err
+		}
+		records = append(records, record)
+	}
+}
+
+// compare the records read against those written, the first of them if fewer were read
+func compare(t *testing.T, read []capture.Record, written []capture.Record) {
+	t.Helper()
+	for i := range read {
+		if i >= len(written) {
+			t.Errorf("read %v records, only %v were written", len(read), len(written))
+			return
+		}
+		if !read[i].Received.Equal(written[i].Received) {
+			t.Errorf("record %v: received at %v, want %v", i, read[i].Received, written[i].Received)
+		}
+		if !bytes.Equal(read[i].Data, written[i].Data) {
+			t.Errorf("record %v: read %v bytes which differ from the %v written", i, len(read[i].Data), len(written[i].Data))
+		}
+	}
+}
+
+// captureOptions the ways a capture can be written
+var captureOptions = []struct {
+	name    string
+	options capture.Options
+}{
+	{"uncompressed", capture.Options{}},
+	{"uncompressed indexed", capture.Options{Index: true}},
+	{"gzip", capture.Options{Compression: capture.CompressionGzip}},
+	{"gzip small blocks", capture.Options{Compression: capture.CompressionGzip, BlockSize: 100}},
+	{"gzip indexed", capture.Options{Compression: capture.CompressionGzip, Index: true}},
+}
+
+func TestRoundTrip(t *testing.T) {
+	created := time.Date(2021, 7, 18, 14, 0, 0, 987654321, time.UTC)
+	for _, test := range captureOptions {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			options := test.options
+			options.Created = created
+			written := records()
+			data := write(t, options, written)
+
+			// Readers which can seek read the index, so read without seeking as well
+			sources := map[string]io.Reader{
+				"seeker": bytes.NewReader(data),
+				"reader": io.MultiReader(bytes.NewReader(data)),
+			}
+			for name, source := range sources {
+				r, err := capture.NewReader(source)
+				if err != nil {
+					t.Fatalf("%v: failed to create reader: %v", name, err)
+				}
+				header := r.Header()
+				if header.Version != capture.Version || header.PacketFormat != 2021 ||
+					!header.Created.Equal(created) || header.Compression != options.Compression {
+					t.Errorf("%v: read header %+v", name, header)
+				}
+
+				read, err := readAll(r)
+				if err != nil {
+					t.Errorf("%v: failed to read record %v: %v", name, len(read), err)
+				}
+				if len(read) != len(written) {
+					t.Errorf("%v: read %v records, want %v", name, len(read), len(written))
+				}
+				compare(t, read, written)
+			}
+		})
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	for _, test := range captureOptions {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			data := write(t, test.options, nil)
+			r, err := capture.NewReader(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("failed to create reader: %v", err)
+			}
+			for i := 0; i < 2; i++ {
+				_, err = r.Next()
+				if err != io.EOF {
+					t.Errorf("reading an empty capture returned %v, want io.EOF", err)
+				}
+			}
+		})
+	}
+
+	_, err := capture.NewReader(bytes.NewReader(nil))
+	if err == nil {
+		t.Errorf("created a reader for an empty file")
+	}
+}
+
+func TestInvalidHeader(t *testing.T) {
+	valid := write(t, capture.Options{}, records())
+
+	notCapture := append([]byte{}, valid...)
+	copy(notCapture, "F1CX")
+	_, err := capture.NewReader(bytes.NewReader(notCapture))
+	if !errors.Is(err, capture.ErrNotCapture) {
+		t.Errorf("reading a file with magic F1CX returned %v, want %v", err, capture.ErrNotCapture)
+	}
+
+	for _, version := range []uint16{0, 1, 2, capture.Version + 1, 0xffff} {
+		file := append(fileHeader(version, 2021, capture.CompressionNone), valid[18:]...)
+		_, err := capture.NewReader(bytes.NewReader(file))
+		if !errors.Is(err, capture.ErrUnsupportedVersion) {
+			t.Errorf("reading version %v returned %v, want %v", version, err, capture.ErrUnsupportedVersion)
+		}
+	}
+
+	// A codec which isn't registered
+	file := append(fileHeader(capture.Version, 2021, capture.Compression(99)), valid[18:]...)
+	_, err = capture.NewReader(bytes.NewReader(file))
+	if !errors.Is(err, capture.ErrUnsupportedCompression) {
+		t.Errorf("reading compression 99 returned %v, want %v", err, capture.ErrUnsupportedCompression)
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	written := records()[:3]
+	for _, test := range []struct {
+		name    string
+		options capture.Options
+	}{
+		{"uncompressed", capture.Options{}},
+		{"gzip", capture.Options{Compression: capture.CompressionGzip, BlockSize: 1}},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			data := write(t, test.options, written)
+			// The offset each record, or block, ends at, a capture cut anywhere else ends part way through one
+			ends := map[int]bool{}
+			complete := 0
+			for size := 18; size <= len(data); size++ {
+				r, err := capture.NewReader(bytes.NewReader(data[:size]))
+				if err != nil {
+					t.Fatalf("%v bytes: failed to create reader: %v", size, err)
+				}
+				read, err := readAll(r)
+				compare(t, read, written)
+				if err == nil {
+					if len(read) < complete {
+						t.Errorf("%v bytes: read %v records, fewer than from a shorter capture", size, len(read))
+					}
+					complete = len(read)
+					ends[size] = true
+				} else if !errors.Is(err, io.ErrUnexpectedEOF) {
+					t.Errorf("%v bytes: reading a truncated capture returned %v, want %v", size, err, io.ErrUnexpectedEOF)
+				}
+			}
+			if complete != len(written) {
+				t.Errorf("read %v records from the whole capture, want %v", complete, len(written))
+			}
+			// The file header and each record or block
+			if len(ends) != len(written)+1 {
+				t.Errorf("read captures truncated at %v without an error, want only the end of each record", ends)
+			}
+		})
+	}
+}
+
+// fileHeader the file header of a capture of a version, packet format and compression
+func fileHeader(version uint16, packetFormat uint16, compression capture.Compression) []byte {
+	header := make([]byte, 18)
+	copy(header, capture.Magic[:])
+	binary.LittleEndian.PutUint16(header[4:], version)
+	binary.LittleEndian.PutUint16(header[6:], packetFormat)
+	binary.LittleEndian.PutUint16(header[16:], uint16(compression))
+	return header
+}
+
+func TestDecompressLimit(t *testing.T) {
+	// A block of a few kilobytes which expands to more than the 64MiB a block may be
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	_, err := w.Write(make([]byte, 1<<26+1))
+	if err != nil {
+		t.Fatalf("failed to compress block: %v", err)
+	}
+	err = w.Close()
+	if err != nil {
+		t.Fatalf("failed to compress block: %v", err)
+	}
+
+	file := fileHeader(capture.Version, 2021, capture.CompressionGzip)
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(compressed.Len()))
+	file = append(file, length[:]...)
+	file = append(file, compressed.Bytes()...)
+
+	r, err := capture.NewReader(bytes.NewReader(file))
+	if err != nil {
+		t.Fatalf("failed to create reader: %v", err)
+	}
+	_, err = r.Next()
+	if err == nil || err == io.EOF {
+		t.Errorf("reading a block which decompresses past the maximum returned %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package capture
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/enum-gen
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+)
+
+// Compression identifies the codec the records of a capture are compressed with
+type Compression uint16
+
+const (
+	// CompressionNone records are stored as they are
+	CompressionNone Compression = 0
+	// CompressionGzip records are stored in gzip compressed blocks
+	CompressionGzip Compression = 1
+	// CompressionZstd records are stored in zstd compressed blocks, a codec must be registered to use it
+	CompressionZstd Compression = 2
+)
+
+// ErrUnsupportedCompression returned when no codec is registered for a capture's compression
+var ErrUnsupportedCompression = errors.New("unsupported compression")
+
+// Codec compresses and decompresses whole blocks of records
+type Codec interface {
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte) ([]byte, error)
+}
+
+var (
+	codecs     = map[Compression]Codec{CompressionGzip: gzipCodec{}}
+	codecsLock = &sync.RWMutex{}
+)
+
+// RegisterCodec register the codec for a compression, i.e. a zstd codec for CompressionZstd,
+// replacing any codec already registered
+func RegisterCodec(compression Compression, codec Codec) {
+	codecsLock.Lock()
+	defer codecsLock.Unlock()
+	codecs[compression] = codec
+}
+
+// HasCodec whether captures can be written and read with a compression,
+// always true for CompressionNone
+func HasCodec(compression Compression) bool {
+	if compression == CompressionNone {
+		return true
+	}
+	_, err := codecFor(compression)
+	return err == nil
+}
+
+// codecFor the codec registered for a compression
+func codecFor(compression Compression) (Codec, error) {
+	codecsLock.RLock()
+	defer codecsLock.RUnlock()
+	codec, ok := codecs[compression]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", ErrUnsupportedCompression, compression)
+	}
+	return codec, nil
+}
+
+type gzipCodec struct{}
+
+// Compress a block with gzip
+func (gzipCodec) Compress(data []byte) ([]byte, error) {
+	var out bytes.Buffer
+	w := gzip.NewWriter(&out)
+	_, err := w.Write(data)
+	if err != nil {
+		return nil, err
+	}
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// Decompress a gzip block
+func (gzipCodec) Decompress(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	// Read one byte past the maximum so a block which expands beyond it is caught without reading the rest
+	block, err := ioutil.ReadAll(io.LimitReader(r, maxBlockSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(block) > maxBlockSize {
+		return nil, fmt.Errorf("block decompresses to more than the maximum of %v bytes", maxBlockSize)
+	}
+	return block, nil
+}
@@ -0,0 +1,68 @@
+// Code generated by enum-gen. DO NOT EDIT.
+
+package capture
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var compressionNames = map[Compression]string{
+	0: "none",
+	1: "gzip",
+	2: "zstd",
+}
+
+var compressionValues = map[string]Compression{
+	"none": 0,
+	"gzip": 1,
+	"zstd": 2,
+}
+
+// String name of the Compression, or the type and number for unknown values
+func (v Compression) String() string {
+	if name, ok := compressionNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("Compression(%d)", v)
+}
+
+// MarshalText name of the Compression, or the number for unknown values
+func (v Compression) MarshalText() ([]byte, error) {
+	if name, ok := compressionNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the Compression from its name or number
+func (v *Compression) UnmarshalText(text []byte) error {
+	if val, ok := compressionValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 16)
+	if err != nil {
+		return fmt.Errorf("unknown Compression %q", text)
+	}
+	*v = Compression(n)
+	return nil
+}
+
+// MarshalJSON name of the Compression as a string, or its number for unknown values
+func (v Compression) MarshalJSON() ([]byte, error) {
+	if name, ok := compressionNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the Compression from its name as a string, or its number
+func (v *Compression) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
@@ -0,0 +1,181 @@
+package capture
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"io"
+	"math"
+)
+
+// ErrNotIndexed returned when seeking in a capture without an index, such as one which was never closed
+var ErrNotIndexed = errors.New("capture has no index")
+
+// ErrNotFound returned when seeking to a record which isn't in the capture
+var ErrNotFound = errors.New("no matching record")
+
+// IndexMagic identifies the trailer at the end of an indexed capture
+var IndexMagic = [4]byte{'F', '1', 'I', 'X'}
+
+// indexMarker record or block length marking the end of the records and the start of the index
+const indexMarker = math.MaxUint32
+
+// indexEntrySize size of an index entry on disk: block, offset, session UID, session time, frame and packet ID
+const indexEntrySize = 8 + 8 + 8 + 4 + 4 + 1
+
+// indexTrailerSize size of the trailer at the end of an indexed capture: index offset and magic
+const indexTrailerSize = 8 + 4
+
+// IndexEntry location of a record in the capture, with the header fields it can be found by
+type IndexEntry struct {
+	// Block byte offset of the compressed block containing the record from the start of the capture,
+	// 0 for uncompressed captures
+	Block int64
+	// Offset byte offset of the record from the start of the capture,
+	// or from the start of the decompressed block for compressed captures
+	Offset int64
+	// SessionUID unique identifier for the session
+	SessionUID uint64
+	// SessionTime timestamp of the session
+	SessionTime float32
+	// FrameIdentifier the frame the data was retrieved on
+	FrameIdentifier uint32
+	// PacketID type of the packet
+	PacketID common.PacketID
+}
+
+// ByPacketID match every record of a packet type
+func ByPacketID(id common.PacketID) func(IndexEntry) bool {
+	return func(entry IndexEntry) bool {
+		return entry.PacketID == id
+	}
+}
+
+// FromFrame match records of a session from the given frame onwards
+func FromFrame(sessionUID uint64, frame uint32) func(IndexEntry) bool {
+	return func(entry IndexEntry) bool {
+		return entry.SessionUID == sessionUID && entry.FrameIdentifier >= frame
+	}
+}
+
+// FromSessionTime match records of a session from the given session time onwards
+func FromSessionTime(sessionUID uint64, sessionTime float32) func(IndexEntry) bool {
+	return func(entry IndexEntry) bool {
+		return entry.SessionUID == sessionUID && entry.SessionTime >= sessionTime
+	}
+}
+
+// newIndexEntry index a record at an offset by its packet header, false if the header can't be decoded
+func newIndexEntry(block int64, offset int64, data []byte) (IndexEntry, bool) {
+	if len(data) < 2 {
+		return IndexEntry{}, false
+	}
+
+	var layout interface {
+		DecodeFrom(data []byte) (int, error)
+		Header() common.Header
+	}
+	// The packet format is always the first field, regardless of the header layout
+	switch format := binary.LittleEndian.Uint16(data); {
+	case format <= common.PacketFormat2019:
+		layout = &common.Header2019{}
+	case format >= common.PacketFormat2023:
+		layout = &common.Header2023{}
+	default:
+		layout = &common.Header{}
+	}
+	_, err := layout.DecodeFrom(data)
+	if err != nil {
+		return IndexEntry{}, false
+	}
+
+	header := layout.Header()
+	return IndexEntry{
+		Block:           block,
+		Offset:          offset,
+		SessionUID:      header.SessionUID,
+		SessionTime:     header.SessionTime,
+		FrameIdentifier: header.FrameIdentifier,
+		PacketID:        header.PacketID,
+	}, true
+}
+
+// writeIndex write the index entries, starting at offset, and the trailer pointing to them
+func writeIndex(w io.Writer, offset int64, index []IndexEntry) error {
+	buf := make([]byte, 4+len(index)*indexEntrySize+indexTrailerSize)
+	binary.LittleEndian.PutUint32(buf[0:], uint32(len(index)))
+
+	b := buf[4:]
+	for _, entry := range index {
+		binary.LittleEndian.PutUint64(b[0:], uint64(entry.Block))
+		binary.LittleEndian.PutUint64(b[8:], uint64(entry.Offset))
+		binary.LittleEndian.PutUint64(b[16:], entry.SessionUID)
+		binary.LittleEndian.PutUint32(b[24:], math.Float32bits(entry.SessionTime))
+		binary.LittleEndian.PutUint32(b[28:], entry.FrameIdentifier)
+		b[32] = uint8(entry.PacketID)
+		b = b[indexEntrySize:]
+	}
+	binary.LittleEndian.PutUint64(b[0:], uint64(offset))
+	copy(b[8:], IndexMagic[:])
+
+	_, err := w.Write(buf)
+	return err
+}
+
+// readIndex read the index from the end of a capture, ErrNotIndexed if it has none
+func readIndex(r io.ReadSeeker) ([]IndexEntry, error) {
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to seek to the end of the capture: %v", err)
+	}
+	if end < fileHeaderSize+4+indexTrailerSize {
+		return nil, ErrNotIndexed
+	}
+
+	var trailer [indexTrailerSize]byte
+	_, err = r.Seek(end-indexTrailerSize, io.SeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to seek to the index trailer: %v", err)
+	}
+	_, err = io.ReadFull(r, trailer[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the index trailer: %v", err)
+	}
+	if [4]byte{trailer[8], trailer[9], trailer[10], trailer[11]} != IndexMagic {
+		return nil, ErrNotIndexed
+	}
+
+	offset := int64(binary.LittleEndian.Uint64(trailer[0:]))
+	if offset < fileHeaderSize || offset > end-indexTrailerSize-4 {
+		return nil, fmt.Errorf("index offset %v is outside of the capture", offset)
+	}
+	_, err = r.Seek(offset, io.SeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to seek to the index: %v", err)
+	}
+	buf := make([]byte, end-indexTrailerSize-offset)
+	_, err = io.ReadFull(r, buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the index: %v", err)
+	}
+
+	count := int(binary.LittleEndian.Uint32(buf))
+	b := buf[4:]
+	if len(b) != count*indexEntrySize {
+		return nil, fmt.Errorf("index of %v entries has %v bytes", count, len(b))
+	}
+	index := make([]IndexEntry, count)
+	for i := range index {
+		index[i] = IndexEntry{
+			Block:           int64(binary.LittleEndian.Uint64(b[0:])),
+			Offset:          int64(binary.LittleEndian.Uint64(b[8:])),
+			SessionUID:      binary.LittleEndian.Uint64(b[16:]),
+			SessionTime:     math.Float32frombits(binary.LittleEndian.Uint32(b[24:])),
+			FrameIdentifier: binary.LittleEndian.Uint32(b[28:]),
+			PacketID:        common.PacketID(b[32]),
+		}
+		b = b[indexEntrySize:]
+	}
+	return index, nil
+}
@@ -0,0 +1,239 @@
+package capture_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/internal/capture"
+	"github.com/roryphillips/f1-telemetry-client/packets"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"io"
+	"testing"
+	"time"
+)
+
+const (
+	firstSession  uint64 = 0x1111
+	secondSession uint64 = 0x2222
+	// frames of each session in the capture
+	frames = 120
+)
+
+// sessionRecords packets of two sessions, with three packet types each frame and a record with no header
+// part way through which can't be indexed
+func sessionRecords(t *testing.T) []capture.Record {
+	t.Helper()
+	writer := packets.NewPacketWriter()
+	start := time.Date(2021, 7, 18, 14, 0, 0, 0, time.UTC)
+	var records []capture.Record
+	for _, uid := range []uint64{firstSession, secondSession} {
+		for frame := uint32(0); frame < frames; frame++ {
+			if uid == secondSession && frame == 0 {
+				records = append(records, capture.Record{Received: start, Data: []byte{0xe5}})
+			}
+			for _, id := range []common.PacketID{common.PacketIDMotion, common.PacketIDLapData, common.PacketIDCarTelemetry} {
+				header, err := writer.Encode(&common.Header{
+					PacketFormat:    2021,
+					PacketID:        id,
+					SessionUID:      uid,
+					SessionTime:     float32(frame) / 60,
+					FrameIdentifier: frame,
+				})
+				if err != nil {
+					t.Fatalf("failed to encode header: %v", err)
+				}
+				// A body which differs for every record
+				data := append(header, byte(uid), byte(frame), byte(id))
+				start = start.Add(time.Millisecond)
+				records = append(records, capture.Record{Received: start, Data: data})
+			}
+		}
+	}
+	return records
+}
+
+// indexOf the position of the first record of a session from a frame in the records, or -1
+func indexOf(records []capture.Record, uid uint64, frame uint32) int {
+	for i, record := range records {
+		if len(record.Data) > 3 && record.Data[len(record.Data)-3] == byte(uid) && record.Data[len(record.Data)-2] == byte(frame) {
+			return i
+		}
+	}
+	return -1
+}
+
+// indexedOptions the ways an indexed capture can be written
+var indexedOptions = []struct {
+	name    string
+	options capture.Options
+}{
+	{"uncompressed", capture.Options{Index: true}},
+	{"gzip", capture.Options{Compression: capture.CompressionGzip, Index: true}},
+	{"gzip small blocks", capture.Options{Compression: capture.CompressionGzip, BlockSize: 200, Index: true}},
+}
+
+func TestSeek(t *testing.T) {
+	written := sessionRecords(t)
+	for _, test := range indexedOptions {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			r, err := capture.NewReader(bytes.NewReader(write(t, test.options, written)))
+			if err != nil {
+				t.Fatalf("failed to create reader: %v", err)
+			}
+			if !r.Indexed() {
+				t.Fatalf("capture written with an index has no index")
+			}
+			if len(r.Index()) != len(written)-1 {
+				t.Errorf("indexed %v records, want %v without the one which has no header", len(r.Index()), len(written)-1)
+			}
+
+			seeks := []struct {
+				name  string
+				match func(capture.IndexEntry) bool
+				// uid and frame of the first record the reader should be positioned at
+				uid   uint64
+				frame uint32
+			}{
+				{"frame", capture.FromFrame(secondSession, 50), secondSession, 50},
+				{"first frame", capture.FromFrame(secondSession, 0), secondSession, 0},
+				{"earlier frame", capture.FromFrame(firstSession, 7), firstSession, 7},
+				{"session time", capture.FromSessionTime(firstSession, 1.5), firstSession, 90},
+				{"session time between frames", capture.FromSessionTime(secondSession, 0.51), secondSession, 31},
+			}
+			for _, seek := range seeks {
+				err := r.Seek(seek.match)
+				if err != nil {
+					t.Errorf("%v: failed to seek: %v", seek.name, err)
+					continue
+				}
+				// Reading carries on in order from the record seeked to, through to the end of the capture
+				want := indexOf(written, seek.uid, seek.frame)
+				read, err := readAll(r)
+				if err != nil {
+					t.Errorf("%v: failed to read after seeking: %v", seek.name, err)
+				}
+				if len(read) != len(written)-want {
+					t.Errorf("%v: read %v records after seeking, want %v", seek.name, len(read), len(written)-want)
+				}
+				compare(t, read, written[want:])
+			}
+
+			err = r.Seek(capture.FromFrame(secondSession, frames))
+			if err != capture.ErrNotFound {
+				t.Errorf("seeking past the last frame returned %v, want %v", err, capture.ErrNotFound)
+			}
+			err = r.Seek(capture.FromFrame(0x3333, 0))
+			if err != capture.ErrNotFound {
+				t.Errorf("seeking to a session not in the capture returned %v, want %v", err, capture.ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	written := sessionRecords(t)
+	for _, test := range indexedOptions {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			r, err := capture.NewReader(bytes.NewReader(write(t, test.options, written)))
+			if err != nil {
+				t.Fatalf("failed to create reader: %v", err)
+			}
+
+			it, err := r.Select(capture.ByPacketID(common.PacketIDLapData))
+			if err != nil {
+				t.Fatalf("failed to select: %v", err)
+			}
+			var want []capture.Record
+			for _, record := range written {
+				if len(record.Data) > 1 && record.Data[len(record.Data)-1] == byte(common.PacketIDLapData) {
+					want = append(want, record)
+				}
+			}
+			var read []capture.Record
+			for {
+				record, entry, err := it.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("failed to read selected record %v: %v", len(read), err)
+				}
+				if entry.PacketID != common.PacketIDLapData {
+					t.Errorf("selected record %v has packet ID %v", len(read), entry.PacketID)
+				}
+				read = append(read, record)
+			}
+			if len(read) != len(want) {
+				t.Errorf("selected %v records, want %v", len(read), len(want))
+			}
+			compare(t, read, want)
+
+			// Nothing matching is an empty selection rather than an error
+			it, err = r.Select(capture.ByPacketID(common.PacketIDSessionHistory))
+			if err != nil {
+				t.Fatalf("failed to select: %v", err)
+			}
+			_, _, err = it.Next()
+			if err != io.EOF {
+				t.Errorf("reading an empty selection returned %v, want io.EOF", err)
+			}
+		})
+	}
+}
+
+func TestNotIndexed(t *testing.T) {
+	written := sessionRecords(t)
+	for _, test := range indexedOptions {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			options := test.options
+			options.Index = false
+			unindexed := write(t, options, written)
+			indexed := write(t, test.options, written)
+
+			captures := map[string]io.Reader{
+				"without an index": bytes.NewReader(unindexed),
+				"without seeking":  io.MultiReader(bytes.NewReader(indexed)),
+			}
+			// Cut after the index marker, so all that's missing is part of the index or its trailer
+			marker := 12
+			if options.Compression != capture.CompressionNone {
+				marker = 4
+			}
+			end := len(unindexed) + marker
+			for _, size := range []int{end, end + 1, end + 4, (end + len(indexed)) / 2, len(indexed) - 12, len(indexed) - 4, len(indexed) - 1} {
+				captures[fmt.Sprintf("truncated to %v bytes", size)] = bytes.NewReader(indexed[:size])
+			}
+
+			for name, c := range captures {
+				r, err := capture.NewReader(c)
+				if err != nil {
+					t.Fatalf("%v: failed to create reader: %v", name, err)
+				}
+				if r.Indexed() {
+					t.Errorf("%v: capture is indexed", name)
+				}
+				err = r.Seek(capture.FromFrame(firstSession, 0))
+				if !errors.Is(err, capture.ErrNotIndexed) {
+					t.Errorf("%v: seeking returned %v, want %v", name, err, capture.ErrNotIndexed)
+				}
+				_, err = r.Select(capture.ByPacketID(common.PacketIDMotion))
+				if !errors.Is(err, capture.ErrNotIndexed) {
+					t.Errorf("%v: selecting returned %v, want %v", name, err, capture.ErrNotIndexed)
+				}
+
+				// Every record can still be read in order
+				read, err := readAll(r)
+				if err != nil {
+					t.Errorf("%v: failed to read record %v: %v", name, len(read), err)
+				}
+				if len(read) != len(written) {
+					t.Errorf("%v: read %v records, want %v", name, len(read), len(written))
+				}
+				compare(t, read, written)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Reader reads records from a capture in the order they were written,
+// captures read from an io.ReadSeeker can also be seeked using their index
+type Reader struct {
+	r      io.Reader
+	br     *bufio.Reader
+	header FileHeader
+	codec  Codec
+	index  []IndexEntry
+	buf    [recordHeaderSize]byte
+	// end whether the end of the records has been reached
+	end bool
+	// offset byte offset of the next block to read, only used with compression
+	offset int64
+	// block decompressed records of the current block, read from blockOffset
+	block       []byte
+	blockOffset int64
+	blockRead   int
+}
+
+// NewReader creates a reader, reading the file header and index of the capture
+func NewReader(r io.Reader) (*Reader, error) {
+	var header [fileHeaderSize]byte
+	_, err := io.ReadFull(r, header[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file header: %v", err)
+	}
+	if [4]byte{header[0], header[1], header[2], header[3]} != Magic {
+		return nil, ErrNotCapture
+	}
+	version := binary.LittleEndian.Uint16(header[4:])
+	if version != Version {
+		return nil, fmt.Errorf("%w %v, only version %v can be read", ErrUnsupportedVersion, version, Version)
+	}
+
+	reader := &Reader{
+		r:  r,
+		br: bufio.NewReader(r),
+		header: FileHeader{
+			Version:      version,
+			PacketFormat: binary.LittleEndian.Uint16(header[6:]),
+			Created:      time.Unix(0, int64(binary.LittleEndian.Uint64(header[8:]))),
+			Compression:  Compression(binary.LittleEndian.Uint16(header[16:])),
+		},
+		offset:      fileHeaderSize,
+		blockOffset: -1,
+	}
+	if reader.header.Compression != CompressionNone {
+		reader.codec, err = codecFor(reader.header.Compression)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if seeker, ok := r.(io.ReadSeeker); ok {
+		reader.index, err = readIndex(seeker)
+		if err != nil && err != ErrNotIndexed {
+			return nil, err
+		}
+		_, err = seeker.Seek(fileHeaderSize, io.SeekStart)
+		if err != nil {
+			return nil, fmt.Errorf("failed to seek to the first record: %v", err)
+		}
+	}
+
+	return reader, nil
+}
+
+// Header the file header of the capture
+func (r *Reader) Header() FileHeader {
+	return r.header
+}
+
+// Indexed whether the capture has an index to seek with
+func (r *Reader) Indexed() bool {
+	return r.index != nil
+}
+
+// Index every indexed record in the capture, in the order they were written
+func (r *Reader) Index() []IndexEntry {
+	return r.index
+}
+
+// Next read the next record, returning io.EOF once all records have been read.
+// A capture which ends part way through a record, such as one still being written,
+// returns io.ErrUnexpectedEOF.
+func (r *Reader) Next() (Record, error) {
+	if r.end {
+		return Record{}, io.EOF
+	}
+	if r.codec != nil {
+		return r.nextInBlock()
+	}
+
+	var record Record
+	_, err := io.ReadFull(r.br, r.buf[:])
+	if err != nil {
+		return record, err
+	}
+	length := binary.LittleEndian.Uint32(r.buf[0:])
+	if length == indexMarker {
+		r.end = true
+		return record, io.EOF
+	}
+	if length > maxRecordSize {
+		return record, fmt.Errorf("record of %v bytes is larger than the maximum of %v", length, maxRecordSize)
+	}
+	record.Received = time.Unix(0, int64(binary.LittleEndian.Uint64(r.buf[4:])))
+
+	record.Data = make([]byte, length)
+	_, err = io.ReadFull(r.br, record.Data)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return record, err
+}
+
+// nextInBlock read the next record from the current block, reading the next block once it's used up
+func (r *Reader) nextInBlock() (Record, error) {
+	var record Record
+	if r.blockRead >= len(r.block) {
+		err := r.readBlock()
+		if err != nil {
+			return record, err
+		}
+	}
+
+	b := r.block[r.blockRead:]
+	if len(b) < recordHeaderSize {
+		return record, fmt.Errorf("block at %v ends part way through a record header", r.blockOffset)
+	}
+	length := binary.LittleEndian.Uint32(b[0:])
+	if length > maxRecordSize {
+		return record, fmt.Errorf("record of %v bytes is larger than the maximum of %v", length, maxRecordSize)
+	}
+	if uint32(len(b)-recordHeaderSize) < length {
+		return record, fmt.Errorf("block at %v ends part way through a record", r.blockOffset)
+	}
+	record.Received = time.Unix(0, int64(binary.LittleEndian.Uint64(b[4:])))
+	record.Data = make([]byte, length)
+	copy(record.Data, b[recordHeaderSize:])
+	r.blockRead += recordHeaderSize + int(length)
+	return record, nil
+}
+
+// readBlock read and decompress the next block, io.EOF once the last block has been read
+func (r *Reader) readBlock() error {
+	var header [blockHeaderSize]byte
+	_, err := io.ReadFull(r.br, header[:])
+	if err != nil {
+		return err
+	}
+	length := binary.LittleEndian.Uint32(header[:])
+	if length == indexMarker {
+		r.end = true
+		return io.EOF
+	}
+	if length > maxBlockSize {
+		return fmt.Errorf("block of %v bytes is larger than the maximum of %v", length, maxBlockSize)
+	}
+
+	compressed := make([]byte, length)
+	_, err = io.ReadFull(r.br, compressed)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+	block, err := r.codec.Decompress(compressed)
+	if err != nil {
+		return fmt.Errorf("failed to decompress block at %v: %v", r.offset, err)
+	}
+	if len(block) > maxBlockSize {
+		return fmt.Errorf("block at %v decompressed to %v bytes, larger than the maximum of %v", r.offset, len(block), maxBlockSize)
+	}
+
+	r.block = block
+	r.blockOffset = r.offset
+	r.blockRead = 0
+	r.offset += blockHeaderSize + int64(length)
+	return nil
+}
+
+// Seek position the reader so Next returns the first record matching, i.e. FromFrame(uid, 120000)
+func (r *Reader) Seek(match func(IndexEntry) bool) error {
+	if !r.Indexed() {
+		return ErrNotIndexed
+	}
+	for _, entry := range r.index {
+		if match(entry) {
+			return r.seekEntry(entry)
+		}
+	}
+	return ErrNotFound
+}
+
+// Select iterate over only the records matching, i.e. ByPacketID(common.PacketIDLapData)
+func (r *Reader) Select(match func(IndexEntry) bool) (*Iterator, error) {
+	if !r.Indexed() {
+		return nil, ErrNotIndexed
+	}
+	var entries []IndexEntry
+	for _, entry := range r.index {
+		if match(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return &Iterator{
+		reader:  r,
+		entries: entries,
+	}, nil
+}
+
+// seekEntry position the reader at an indexed record
+func (r *Reader) seekEntry(entry IndexEntry) error {
+	r.end = false
+	if r.codec == nil {
+		return r.seekOffset(entry.Offset)
+	}
+
+	// Records selected from the same block only need it decompressing once, but the next block
+	// has to be read from the end of it however far the reader has got since
+	if entry.Block == r.blockOffset {
+		err := r.seekOffset(r.offset)
+		if err != nil {
+			return err
+		}
+	} else {
+		err := r.seekOffset(entry.Block)
+		if err != nil {
+			return err
+		}
+		r.offset = entry.Block
+		err = r.readBlock()
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read block at %v: %v", entry.Block, err)
+		}
+	}
+	if entry.Offset < 0 || entry.Offset >= int64(len(r.block)) {
+		return fmt.Errorf("record offset %v is outside of the block at %v", entry.Offset, entry.Block)
+	}
+	r.blockRead = int(entry.Offset)
+	return nil
+}
+
+// seekOffset position the underlying reader at an offset
+func (r *Reader) seekOffset(offset int64) error {
+	seeker, ok := r.r.(io.Seeker)
+	if !ok {
+		return ErrNotIndexed
+	}
+	_, err := seeker.Seek(offset, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("failed to seek to %v: %v", offset, err)
+	}
+	r.br.Reset(r.r)
+	return nil
+}
+
+// Iterator reads the records selected from an indexed capture
+type Iterator struct {
+	reader  *Reader
+	entries []IndexEntry
+	next    int
+}
+
+// Next read the next selected record and its index entry, returning io.EOF once all have been read
+func (it *Iterator) Next() (Record, IndexEntry, error) {
+	if it.next >= len(it.entries) {
+		return Record{}, IndexEntry{}, io.EOF
+	}
+	entry := it.entries[it.next]
+	it.next++
+
+	err := it.reader.seekEntry(entry)
+	if err != nil {
+		return Record{}, entry, err
+	}
+	record, err := it.reader.Next()
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return record, entry, err
+}
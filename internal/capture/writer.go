@@ -0,0 +1,197 @@
+package capture
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// DefaultBlockSize size records are buffered up to before being compressed into a block
+const DefaultBlockSize = 256 * 1024
+
+// Options how a capture is written
+type Options struct {
+	// Index write an index of every record when the capture is closed, so readers can seek
+	Index bool
+	// Compression codec to compress blocks of records with, CompressionNone to store them as they are
+	Compression Compression
+	// BlockSize size in bytes of the records in each compressed block, DefaultBlockSize if 0
+	BlockSize int
+	// Created when the capture was started, now if zero, i.e. to keep the time of a converted capture
+	Created time.Time
+}
+
+// Writer appends records to a capture
+type Writer struct {
+	w       *bufio.Writer
+	options Options
+	codec   Codec
+	buf     [recordHeaderSize]byte
+	// block records waiting to be compressed, only used with compression
+	block  bytes.Buffer
+	offset int64
+	index  []IndexEntry
+}
+
+// NewWriter creates a writer, writing the file header for a capture of packets from the given packet format
+func NewWriter(w io.Writer, packetFormat uint16, options Options) (*Writer, error) {
+	writer := &Writer{
+		options: options,
+		offset:  fileHeaderSize,
+	}
+	if options.Compression != CompressionNone {
+		codec, err := codecFor(options.Compression)
+		if err != nil {
+			return nil, err
+		}
+		writer.codec = codec
+		if writer.options.BlockSize == 0 {
+			writer.options.BlockSize = DefaultBlockSize
+		}
+		if writer.options.BlockSize < 0 || writer.options.BlockSize > maxBlockSize {
+			return nil, fmt.Errorf("block size %v must be between 1 and %v", writer.options.BlockSize, maxBlockSize)
+		}
+	}
+
+	created := options.Created
+	if created.IsZero() {
+		created = time.Now()
+	}
+
+	bw := bufio.NewWriter(w)
+	var header [fileHeaderSize]byte
+	copy(header[0:4], Magic[:])
+	binary.LittleEndian.PutUint16(header[4:], Version)
+	binary.LittleEndian.PutUint16(header[6:], packetFormat)
+	binary.LittleEndian.PutUint64(header[8:], uint64(created.UnixNano()))
+	binary.LittleEndian.PutUint16(header[16:], uint16(options.Compression))
+	_, err := bw.Write(header[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to write file header: %v", err)
+	}
+	writer.w = bw
+
+	return writer, nil
+}
+
+// Write append a record to the capture.
+// With compression the record is buffered until the block is full or the writer is flushed.
+// The record's data is copied, so its buffer can be reused once Write returns.
+func (w *Writer) Write(record Record) error {
+	if len(record.Data) > maxRecordSize {
+		return fmt.Errorf("record of %v bytes is larger than the maximum of %v", len(record.Data), maxRecordSize)
+	}
+
+	binary.LittleEndian.PutUint32(w.buf[0:], uint32(len(record.Data)))
+	binary.LittleEndian.PutUint64(w.buf[4:], uint64(record.Received.UnixNano()))
+
+	if w.codec != nil {
+		w.indexRecord(w.offset, int64(w.block.Len()), record.Data)
+		w.block.Write(w.buf[:])
+		w.block.Write(record.Data)
+		if w.block.Len() >= w.options.BlockSize {
+			return w.writeBlock()
+		}
+		return nil
+	}
+
+	_, err := w.w.Write(w.buf[:])
+	if err != nil {
+		return fmt.Errorf("failed to write record header: %v", err)
+	}
+	_, err = w.w.Write(record.Data)
+	if err != nil {
+		return fmt.Errorf("failed to write record data: %v", err)
+	}
+	w.indexRecord(0, w.offset, record.Data)
+	w.offset += recordHeaderSize + int64(len(record.Data))
+	return nil
+}
+
+// indexRecord add a record to the index, if enabled
+func (w *Writer) indexRecord(block int64, offset int64, data []byte) {
+	if !w.options.Index {
+		return
+	}
+	// Records without a readable packet header can only be found by reading the capture in order
+	if entry, ok := newIndexEntry(block, offset, data); ok {
+		w.index = append(w.index, entry)
+	}
+}
+
+// writeBlock compress the buffered records and write them as a block
+func (w *Writer) writeBlock() error {
+	if w.block.Len() == 0 {
+		return nil
+	}
+	compressed, err := w.codec.Compress(w.block.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to compress block: %v", err)
+	}
+	if len(compressed) > maxBlockSize {
+		return fmt.Errorf("compressed block of %v bytes is larger than the maximum of %v", len(compressed), maxBlockSize)
+	}
+
+	var header [blockHeaderSize]byte
+	binary.LittleEndian.PutUint32(header[:], uint32(len(compressed)))
+	_, err = w.w.Write(header[:])
+	if err != nil {
+		return fmt.Errorf("failed to write block header: %v", err)
+	}
+	_, err = w.w.Write(compressed)
+	if err != nil {
+		return fmt.Errorf("failed to write block: %v", err)
+	}
+	w.offset += blockHeaderSize + int64(len(compressed))
+	w.block.Reset()
+	return nil
+}
+
+// Flush write any buffered records to the underlying writer.
+// With compression this ends the current block, so flushing often makes for smaller blocks.
+func (w *Writer) Flush() error {
+	if w.codec != nil {
+		err := w.writeBlock()
+		if err != nil {
+			return err
+		}
+	}
+	return w.w.Flush()
+}
+
+// Close write the index, if enabled, and flush any buffered records.
+// The underlying writer is not closed, and no more records can be written.
+func (w *Writer) Close() error {
+	if w.codec != nil {
+		err := w.writeBlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	if w.options.Index {
+		// The marker takes the place of the next record, or block, so readers stop before the index
+		var marker []byte
+		if w.codec != nil {
+			marker = make([]byte, blockHeaderSize)
+		} else {
+			marker = make([]byte, recordHeaderSize)
+		}
+		binary.LittleEndian.PutUint32(marker, indexMarker)
+		_, err := w.w.Write(marker)
+		if err != nil {
+			return fmt.Errorf("failed to write index marker: %v", err)
+		}
+		w.offset += int64(len(marker))
+
+		err = writeIndex(w.w, w.offset, w.index)
+		if err != nil {
+			return fmt.Errorf("failed to write index: %v", err)
+		}
+		w.index = nil
+	}
+	return w.w.Flush()
+}
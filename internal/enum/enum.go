@@ -0,0 +1,185 @@
+package enum
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sync"
+)
+
+var (
+	textMarshaler = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	emptyType     = reflect.TypeOf((*interface{})(nil)).Elem()
+	// mirrors numeric mirror of each type converted so far
+	mirrors = &sync.Map{}
+)
+
+// Numeric wraps a value so it's marshalled to JSON with enums as their numeric values rather than their names,
+// for consumers of the JSON output from before enums had names. Only the returned value is affected.
+func Numeric(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	return convert(reflect.ValueOf(v)).Interface()
+}
+
+// mirror a type with every enum replaced by its underlying integer type
+type mirror struct {
+	t reflect.Type
+	// changed whether values have to be converted, either as the type differs or it holds interfaces
+	// whose values may need converting
+	changed bool
+}
+
+// isEnum whether a type is an integer which marshals itself, i.e. a generated enum
+func isEnum(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return t.Implements(textMarshaler) || t.Implements(jsonMarshaler)
+	}
+	return false
+}
+
+// integer the unnamed integer type of a kind
+func integer(kind reflect.Kind) reflect.Type {
+	switch kind {
+	case reflect.Int8:
+		return reflect.TypeOf(int8(0))
+	case reflect.Int16:
+		return reflect.TypeOf(int16(0))
+	case reflect.Int32:
+		return reflect.TypeOf(int32(0))
+	case reflect.Int64:
+		return reflect.TypeOf(int64(0))
+	case reflect.Uint:
+		return reflect.TypeOf(uint(0))
+	case reflect.Uint8:
+		return reflect.TypeOf(uint8(0))
+	case reflect.Uint16:
+		return reflect.TypeOf(uint16(0))
+	case reflect.Uint32:
+		return reflect.TypeOf(uint32(0))
+	case reflect.Uint64:
+		return reflect.TypeOf(uint64(0))
+	}
+	return reflect.TypeOf(0)
+}
+
+// mirrorOf the numeric mirror of a type, built once per type
+func mirrorOf(t reflect.Type) mirror {
+	if m, ok := mirrors.Load(t); ok {
+		return m.(mirror)
+	}
+	m := buildMirror(t)
+	mirrors.Store(t, m)
+	return m
+}
+
+func buildMirror(t reflect.Type) mirror {
+	if isEnum(t) {
+		return mirror{t: integer(t.Kind()), changed: true}
+	}
+	// Other types which marshal themselves are left as they are
+	if t.Implements(textMarshaler) || t.Implements(jsonMarshaler) {
+		return mirror{t: t}
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		return mirror{t: emptyType, changed: true}
+	case reflect.Ptr:
+		elem := mirrorOf(t.Elem())
+		return mirror{t: reflect.PtrTo(elem.t), changed: elem.changed}
+	case reflect.Array:
+		elem := mirrorOf(t.Elem())
+		return mirror{t: reflect.ArrayOf(t.Len(), elem.t), changed: elem.changed}
+	case reflect.Slice:
+		elem := mirrorOf(t.Elem())
+		return mirror{t: reflect.SliceOf(elem.t), changed: elem.changed}
+	case reflect.Map:
+		key, elem := mirrorOf(t.Key()), mirrorOf(t.Elem())
+		return mirror{t: reflect.MapOf(key.t, elem.t), changed: key.changed || elem.changed}
+	case reflect.Struct:
+		changed := false
+		var fields []reflect.StructField
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			// Unexported fields aren't marshalled, and can't be part of a struct built at runtime
+			if field.PkgPath != "" {
+				continue
+			}
+			m := mirrorOf(field.Type)
+			changed = changed || m.changed
+			field.Type = m.t
+			fields = append(fields, field)
+		}
+		if !changed {
+			return mirror{t: t}
+		}
+		return mirror{t: reflect.StructOf(fields), changed: true}
+	}
+	return mirror{t: t}
+}
+
+// convert a value into its numeric mirror
+func convert(v reflect.Value) reflect.Value {
+	m := mirrorOf(v.Type())
+	if !m.changed {
+		return v
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return reflect.Zero(m.t)
+		}
+		return convert(v.Elem())
+	case reflect.Ptr:
+		if v.IsNil() {
+			return reflect.Zero(m.t)
+		}
+		out := reflect.New(m.t.Elem())
+		out.Elem().Set(convert(v.Elem()))
+		return out
+	case reflect.Array:
+		out := reflect.New(m.t).Elem()
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(convert(v.Index(i)))
+		}
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(m.t)
+		}
+		out := reflect.MakeSlice(m.t, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(convert(v.Index(i)))
+		}
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return reflect.Zero(m.t)
+		}
+		out := reflect.MakeMapWithSize(m.t, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out.SetMapIndex(convert(iter.Key()), convert(iter.Value()))
+		}
+		return out
+	case reflect.Struct:
+		out := reflect.New(m.t).Elem()
+		j := 0
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			out.Field(j).Set(convert(v.Field(i)))
+			j++
+		}
+		return out
+	}
+	// Enums
+	return v.Convert(m.t)
+}
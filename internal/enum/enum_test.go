@@ -0,0 +1,58 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"github.com/roryphillips/f1-telemetry-client/internal/enum"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/event"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+	"strings"
+	"testing"
+)
+
+func TestNumeric(t *testing.T) {
+	packet := &session.Packet2022{Header: common.Header{PacketID: common.PacketIDSession}}
+	packet.Weather = session.WeatherTypeHeavyRain
+	packet.Session = session.SessionTypeQualifying2
+
+	data, err := json.Marshal(enum.Numeric(packet))
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	for _, want := range []string{`"packet_id":1,`, `"weather":4,`, `"session":6,`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("%s does not contain %s", data, want)
+		}
+	}
+	var decoded session.Packet2022
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if decoded != *packet {
+		t.Errorf("unmarshalled %+v, want %+v", decoded, *packet)
+	}
+
+	// Only the wrapped value is affected
+	data, err = json.Marshal(packet)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if !strings.Contains(string(data), `"weather":"heavy_rain"`) {
+		t.Errorf("%s does not name the weather", data)
+	}
+}
+
+func TestNumericInterface(t *testing.T) {
+	packet := &event.Packet{
+		EventCode: event.EventCodePenalty,
+		Details:   &event.Penalty{PenaltyType: event.PenaltyTypePenaltyReminder},
+	}
+	data, err := json.Marshal(enum.Numeric(packet))
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if !strings.Contains(string(data), `"penalty_type":3,`) {
+		t.Errorf("%s does not contain the numeric penalty type", data)
+	}
+}
@@ -0,0 +1,105 @@
+package lookup
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+)
+
+// Names display names for the identifiers in a packet, empty where an identifier isn't known
+type Names struct {
+	// Track name of the track the session takes place at
+	Track string `json:"track,omitempty"`
+	// Drivers name of the driver of each car
+	Drivers []string `json:"drivers,omitempty"`
+	// Teams name of the team of each car
+	Teams []string `json:"teams,omitempty"`
+	// Nationalities name of the nationality of each car's driver
+	Nationalities []string `json:"nationalities,omitempty"`
+}
+
+// trackPacket a packet identifying a track, i.e. every session packet
+type trackPacket interface {
+	TrackID() session.TrackType
+}
+
+// driverPacket a packet identifying the driver of each car, i.e. every participants packet
+type driverPacket interface {
+	DriverIDs() []uint8
+}
+
+// teamPacket a packet identifying the team of each car, i.e. participants and lobby info packets
+type teamPacket interface {
+	TeamIDs() []uint8
+}
+
+// nationalityPacket a packet identifying the nationality of each car's driver, i.e. participants and lobby info packets
+type nationalityPacket interface {
+	NationalityIDs() []uint8
+}
+
+// NamesOf the names of the identifiers in a decoded packet of a packet format,
+// false for packets without identifiers to name
+func NamesOf(format uint16, packet interface{}) (Names, bool) {
+	t := tablesFor(format)
+	var names Names
+	found := false
+	if p, ok := packet.(trackPacket); ok {
+		names.Track = t.tracks[p.TrackID()].Name
+		found = true
+	}
+	if p, ok := packet.(driverPacket); ok {
+		names.Drivers = nameAll(t.drivers, p.DriverIDs())
+		found = true
+	}
+	if p, ok := packet.(teamPacket); ok {
+		names.Teams = nameAll(t.teams, p.TeamIDs())
+		found = true
+	}
+	if p, ok := packet.(nationalityPacket); ok {
+		names.Nationalities = nameAll(t.nationalities, p.NationalityIDs())
+		found = true
+	}
+	return names, found
+}
+
+// nameAll the name of each identifier, empty where not known
+func nameAll(table map[uint8]string, ids []uint8) []string {
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		names[i] = table[id]
+	}
+	return names
+}
+
+// Annotated a packet with the names of its identifiers, marshalled to JSON as the packet's own object
+// with the names added as a "names" field. The packet may be anything which marshals to an object,
+// i.e. a decoded packet or its enum.Numeric wrapper.
+type Annotated struct {
+	Packet interface{}
+	Names  Names
+}
+
+// MarshalJSON the packet's object with the names added as its last field
+func (a Annotated) MarshalJSON() ([]byte, error) {
+	packet, err := json.Marshal(a.Packet)
+	if err != nil {
+		return nil, err
+	}
+	if len(packet) < 2 || packet[0] != '{' || packet[len(packet)-1] != '}' {
+		return nil, fmt.Errorf("unable to annotate %T which doesn't marshal to a JSON object", a.Packet)
+	}
+	names, err := json.Marshal(a.Names)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(packet)+len(names)+10)
+	out = append(out, packet[:len(packet)-1]...)
+	if len(packet) > 2 {
+		out = append(out, ',')
+	}
+	out = append(out, `"names":`...)
+	out = append(out, names...)
+	return append(out, '}'), nil
+}
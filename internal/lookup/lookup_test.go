@@ -0,0 +1,173 @@
+package lookup_test
+
+import (
+	"encoding/json"
+	"github.com/roryphillips/f1-telemetry-client/internal/enum"
+	"github.com/roryphillips/f1-telemetry-client/internal/lookup"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/lobby_info"
+	"github.com/roryphillips/f1-telemetry-client/packets/motion"
+	"github.com/roryphillips/f1-telemetry-client/packets/participants"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+	"strconv"
+	"testing"
+)
+
+// formats every packet format with its own lookup tables
+var formats = []uint16{2019, 2020, 2021, 2022}
+
+// trackSince the first game with each track added after F1 2019
+var trackSince = map[session.TrackType]uint16{
+	session.TrackTypeHanoi:     2020,
+	session.TrackTypeZandvoort: 2020,
+	session.TrackTypeImola:     2021,
+	session.TrackTypePortimao:  2021,
+	session.TrackTypeJeddah:    2021,
+	session.TrackTypeMiami:     2022,
+}
+
+func TestTracks(t *testing.T) {
+	for _, format := range formats {
+		for i := -128; i < 128; i++ {
+			track := session.TrackType(i)
+			text, _ := track.MarshalText()
+			// Only values with a name are defined, and unknown has no track to name
+			if _, err := strconv.Atoi(string(text)); err == nil || track == session.TrackTypeUnknown {
+				continue
+			}
+
+			info, ok := lookup.TrackInfo(format, track)
+			since, added := trackSince[track]
+			if added && format < since {
+				if ok {
+					t.Errorf("%v: %v has track info %+v, but was added in %v", format, track, info, since)
+				}
+				continue
+			}
+			if !ok || info.Name == "" {
+				t.Errorf("%v: no track info for %v", format, track)
+			}
+		}
+	}
+}
+
+func TestNationalities(t *testing.T) {
+	// Every game numbers its nationalities from 1 without gaps
+	last := map[uint16]uint8{2019: 86, 2020: 87, 2021: 88, 2022: 88}
+	for _, format := range formats {
+		for id := 0; id < 256; id++ {
+			name, ok := lookup.NationalityName(format, uint8(id))
+			want := id >= 1 && id <= int(last[format])
+			if ok != want || (ok && name == "") {
+				t.Errorf("%v: nationality %v is %q, %v", format, id, name, ok)
+			}
+		}
+	}
+}
+
+func TestTeamsAndDrivers(t *testing.T) {
+	for _, format := range formats {
+		for id := 0; id < 256; id++ {
+			if name, ok := lookup.TeamName(format, uint8(id)); ok && name == "" {
+				t.Errorf("%v: team %v has an empty name", format, id)
+			}
+			if name, ok := lookup.DriverName(format, uint8(id)); ok && name == "" {
+				t.Errorf("%v: driver %v has an empty name", format, id)
+			}
+		}
+		// Identifiers which are the same in every game
+		if name, _ := lookup.TeamName(format, 0); name != "Mercedes" {
+			t.Errorf("%v: team 0 is %q, want Mercedes", format, name)
+		}
+		if name, _ := lookup.DriverName(format, 7); name != "Lewis Hamilton" {
+			t.Errorf("%v: driver 7 is %q, want Lewis Hamilton", format, name)
+		}
+		// 255 is a network human or no team selected
+		if name, ok := lookup.TeamName(format, 255); ok {
+			t.Errorf("%v: team 255 is %q", format, name)
+		}
+		if name, ok := lookup.DriverName(format, 255); ok {
+			t.Errorf("%v: driver 255 is %q", format, name)
+		}
+	}
+}
+
+func TestNamesOf(t *testing.T) {
+	header := common.Header{PacketFormat: 2021}
+	sessionPacket := &session.Packet2021{Header: header, Track: session.TrackTypeImola}
+	names, ok := lookup.NamesOf(2021, sessionPacket)
+	if !ok || names.Track != "Imola" || names.Drivers != nil {
+		t.Errorf("session names %+v, %v", names, ok)
+	}
+	// Imola wasn't in F1 2020
+	names, ok = lookup.NamesOf(2020, &session.Packet{Track: session.TrackTypeImola})
+	if !ok || names.Track != "" {
+		t.Errorf("F1 2020 session names %+v, %v", names, ok)
+	}
+
+	participantsPacket := &participants.Packet2021{Header: header}
+	participantsPacket.Participants[0] = participants.ParticipantData2021{DriverID: 7, TeamID: 0, Nationality: 10}
+	participantsPacket.Participants[1] = participants.ParticipantData2021{DriverID: 255, TeamID: 255, Nationality: 0}
+	names, ok = lookup.NamesOf(2021, participantsPacket)
+	if !ok || len(names.Drivers) != 22 || len(names.Teams) != 22 || len(names.Nationalities) != 22 {
+		t.Fatalf("participants names %+v, %v", names, ok)
+	}
+	if names.Drivers[0] != "Lewis Hamilton" || names.Teams[0] != "Mercedes" || names.Nationalities[0] != "British" {
+		t.Errorf("participant 0 is %q of %q, %q", names.Drivers[0], names.Teams[0], names.Nationalities[0])
+	}
+	if names.Drivers[1] != "" || names.Teams[1] != "" || names.Nationalities[1] != "" {
+		t.Errorf("participant with unknown identifiers is %q of %q, %q", names.Drivers[1], names.Teams[1], names.Nationalities[1])
+	}
+
+	lobbyPacket := &lobby_info.Packet{}
+	lobbyPacket.LobbyPlayers[0] = lobby_info.LobbyInfoData{TeamID: 1, Nationality: 10}
+	names, ok = lookup.NamesOf(2020, lobbyPacket)
+	if !ok || names.Drivers != nil || names.Teams[0] != "Ferrari" || names.Nationalities[0] != "British" {
+		t.Errorf("lobby names %+v, %v", names, ok)
+	}
+
+	_, ok = lookup.NamesOf(2021, &motion.Packet{})
+	if ok {
+		t.Errorf("found names for a motion packet")
+	}
+}
+
+func TestAnnotated(t *testing.T) {
+	packet := &session.Packet2021{Header: common.Header{PacketFormat: 2021}, Track: session.TrackTypeSilverstone}
+	names, _ := lookup.NamesOf(2021, packet)
+
+	for _, value := range []interface{}{packet, enum.Numeric(packet)} {
+		out, err := json.Marshal(lookup.Annotated{Packet: value, Names: names})
+		if err != nil {
+			t.Fatalf("failed to marshal annotated packet: %v", err)
+		}
+		var fields map[string]json.RawMessage
+		err = json.Unmarshal(out, &fields)
+		if err != nil {
+			t.Fatalf("annotated packet isn't a JSON object: %v", err)
+		}
+		if string(fields["names"]) != `{"track":"Silverstone"}` {
+			t.Errorf("names are %s", fields["names"])
+		}
+		if _, ok := fields["weather_forecast_samples"]; !ok {
+			t.Errorf("annotated packet is missing the packet's own fields: %s", out)
+		}
+	}
+
+	// Only the packet's own output changes with numeric enums
+	out, _ := json.Marshal(lookup.Annotated{Packet: enum.Numeric(packet), Names: names})
+	var fields map[string]json.RawMessage
+	_ = json.Unmarshal(out, &fields)
+	if string(fields["track"]) != "7" {
+		t.Errorf("numeric track is %s", fields["track"])
+	}
+
+	out, err := json.Marshal(lookup.Annotated{Packet: struct{}{}, Names: lookup.Names{Track: "Monza"}})
+	if err != nil || string(out) != `{"names":{"track":"Monza"}}` {
+		t.Errorf("annotated empty object is %s, %v", out, err)
+	}
+	_, err = json.Marshal(lookup.Annotated{Packet: []int{1}, Names: names})
+	if err == nil {
+		t.Errorf("annotated a packet which isn't an object")
+	}
+}
@@ -0,0 +1,64 @@
+package lookup
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+)
+
+// tables the lookup tables for a single game
+type tables struct {
+	drivers       map[uint8]string
+	teams         map[uint8]string
+	nationalities map[uint8]string
+	tracks        map[session.TrackType]Track
+}
+
+var (
+	tables2019 = tables{drivers: drivers2019, teams: teams2019, nationalities: nationalities2019, tracks: tracks2019}
+	tables2020 = tables{drivers: drivers2020, teams: teams2020, nationalities: nationalities2020, tracks: tracks2020}
+	tables2021 = tables{drivers: drivers2021, teams: teams2021, nationalities: nationalities2021, tracks: tracks2021}
+	tables2022 = tables{drivers: drivers2022, teams: teams2022, nationalities: nationalities2021, tracks: tracks2022}
+)
+
+// tablesFor the lookup tables for a packet format, later games use the tables of the latest known game
+func tablesFor(format uint16) tables {
+	switch {
+	case format <= common.PacketFormat2019:
+		return tables2019
+	case format == common.PacketFormat2020:
+		return tables2020
+	case format == common.PacketFormat2021:
+		return tables2021
+	}
+	return tables2022
+}
+
+// TeamName name of a team in a packet format, i.e. "Mercedes"
+func TeamName(format uint16, id uint8) (string, bool) {
+	name, ok := tablesFor(format).teams[id]
+	return name, ok
+}
+
+// DriverName name of a driver in a packet format, i.e. "Lewis Hamilton"
+func DriverName(format uint16, id uint8) (string, bool) {
+	name, ok := tablesFor(format).drivers[id]
+	return name, ok
+}
+
+// NationalityName name of a nationality in a packet format, i.e. "British"
+func NationalityName(format uint16, id uint8) (string, bool) {
+	name, ok := tablesFor(format).nationalities[id]
+	return name, ok
+}
+
+// extend copy the names of an earlier game, adding or replacing names
+func extend(base map[uint8]string, changes map[uint8]string) map[uint8]string {
+	out := make(map[uint8]string, len(base)+len(changes))
+	for id, name := range base {
+		out[id] = name
+	}
+	for id, name := range changes {
+		out[id] = name
+	}
+	return out
+}
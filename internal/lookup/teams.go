@@ -0,0 +1,154 @@
+package lookup
+
+var teams2019 = map[uint8]string{
+	0:  "Mercedes",
+	1:  "Ferrari",
+	2:  "Red Bull Racing",
+	3:  "Williams",
+	4:  "Racing Point",
+	5:  "Renault",
+	6:  "Toro Rosso",
+	7:  "Haas",
+	8:  "McLaren",
+	9:  "Alfa Romeo",
+	10: "McLaren 1988",
+	11: "McLaren 1991",
+	12: "Williams 1992",
+	13: "Ferrari 1995",
+	14: "Williams 1996",
+	15: "McLaren 1998",
+	16: "Ferrari 2002",
+	17: "Ferrari 2004",
+	18: "Renault 2006",
+	19: "Ferrari 2007",
+	21: "Red Bull 2010",
+	22: "Ferrari 1976",
+	23: "ART Grand Prix",
+	24: "Campos Vexatec Racing",
+	25: "Carlin",
+	26: "Charouz Racing System",
+	27: "DAMS",
+	28: "Russian Time",
+	29: "MP Motorsport",
+	30: "Pertamina",
+	31: "McLaren 1990",
+	32: "Trident",
+	33: "BWT Arden",
+	34: "McLaren 1976",
+	35: "Lotus 1972",
+	36: "Ferrari 1979",
+	37: "McLaren 1982",
+	38: "Williams 2003",
+	39: "Brawn 2009",
+	40: "Lotus 1978",
+	42: "Art GP '19",
+	43: "Campos '19",
+	44: "Carlin '19",
+	45: "Sauber Junior Charouz '19",
+	46: "Dams '19",
+	47: "Uni-Virtuosi '19",
+	48: "MP Motorsport '19",
+	49: "Prema '19",
+	50: "Trident '19",
+	51: "Arden '19",
+	63: "Ferrari 1990",
+	64: "McLaren 2010",
+	65: "Ferrari 2010",
+}
+
+var teams2020 = extend(teams2019, map[uint8]string{
+	6:  "AlphaTauri",
+	53: "Benetton 1994",
+	54: "Benetton 1995",
+	55: "Ferrari 2000",
+	56: "Jordan 1991",
+})
+
+var teams2021 = map[uint8]string{
+	0:  "Mercedes",
+	1:  "Ferrari",
+	2:  "Red Bull Racing",
+	3:  "Williams",
+	4:  "Aston Martin",
+	5:  "Alpine",
+	6:  "AlphaTauri",
+	7:  "Haas",
+	8:  "McLaren",
+	9:  "Alfa Romeo",
+	42: "Art GP '19",
+	43: "Campos '19",
+	44: "Carlin '19",
+	45: "Sauber Junior Charouz '19",
+	46: "Dams '19",
+	47: "Uni-Virtuosi '19",
+	48: "MP Motorsport '19",
+	49: "Prema '19",
+	50: "Trident '19",
+	51: "Arden '19",
+	70: "Art GP '20",
+	71: "Campos '20",
+	72: "Carlin '20",
+	73: "Charouz '20",
+	74: "Dams '20",
+	75: "Uni-Virtuosi '20",
+	76: "MP Motorsport '20",
+	77: "Prema '20",
+	78: "Trident '20",
+	79: "BWT '20",
+	80: "Hitech '20",
+	85: "Mercedes 2020",
+	86: "Ferrari 2020",
+	87: "Red Bull 2020",
+	88: "Williams 2020",
+	89: "Racing Point 2020",
+	90: "Renault 2020",
+	91: "AlphaTauri 2020",
+	92: "Haas 2020",
+	93: "McLaren 2020",
+	94: "Alfa Romeo 2020",
+}
+
+var teams2022 = map[uint8]string{
+	0:   "Mercedes",
+	1:   "Ferrari",
+	2:   "Red Bull Racing",
+	3:   "Williams",
+	4:   "Aston Martin",
+	5:   "Alpine",
+	6:   "AlphaTauri",
+	7:   "Haas",
+	8:   "McLaren",
+	9:   "Alfa Romeo",
+	85:  "Mercedes 2020",
+	86:  "Ferrari 2020",
+	87:  "Red Bull 2020",
+	88:  "Williams 2020",
+	89:  "Racing Point 2020",
+	90:  "Renault 2020",
+	91:  "AlphaTauri 2020",
+	92:  "Haas 2020",
+	93:  "McLaren 2020",
+	94:  "Alfa Romeo 2020",
+	95:  "Aston Martin DB11 V12",
+	96:  "Aston Martin Vantage F1 Edition",
+	97:  "Aston Martin Vantage Safety Car",
+	98:  "Ferrari F8 Tributo",
+	99:  "Ferrari Roma",
+	100: "McLaren 720S",
+	101: "McLaren Artura",
+	102: "Mercedes AMG GT Black Series Safety Car",
+	103: "Mercedes AMG GTR Pro",
+	104: "F1 Custom Team",
+	106: "Prema '21",
+	107: "Uni-Virtuosi '21",
+	108: "Carlin '21",
+	109: "Hitech '21",
+	110: "Art GP '21",
+	111: "MP Motorsport '21",
+	112: "Charouz '21",
+	113: "Dams '21",
+	114: "Campos '21",
+	115: "BWT '21",
+	116: "Trident '21",
+	117: "Mercedes AMG GT Black Series",
+}
@@ -0,0 +1,77 @@
+package lookup
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+)
+
+// Track metadata for a track
+type Track struct {
+	// Name short name of the track, i.e. "Silverstone"
+	Name string `json:"name"`
+	// Length official lap length in metres, 0 where not known
+	Length uint16 `json:"length"`
+}
+
+var tracks2019 = map[session.TrackType]Track{
+	session.TrackTypeMelbourne:        {Name: "Melbourne", Length: 5303},
+	session.TrackTypePaulRicard:       {Name: "Paul Ricard", Length: 5842},
+	session.TrackTypeShanghai:         {Name: "Shanghai", Length: 5451},
+	session.TrackTypeSakhir:           {Name: "Sakhir (Bahrain)", Length: 5412},
+	session.TrackTypeCatalunya:        {Name: "Catalunya", Length: 4655},
+	session.TrackTypeMonaco:           {Name: "Monaco", Length: 3337},
+	session.TrackTypeMontreal:         {Name: "Montreal", Length: 4361},
+	session.TrackTypeSilverstone:      {Name: "Silverstone", Length: 5891},
+	session.TrackTypeHockenheim:       {Name: "Hockenheim", Length: 4574},
+	session.TrackTypeHungaroring:      {Name: "Hungaroring", Length: 4381},
+	session.TrackTypeSpa:              {Name: "Spa", Length: 7004},
+	session.TrackTypeMonza:            {Name: "Monza", Length: 5793},
+	session.TrackTypeSingapore:        {Name: "Singapore", Length: 5063},
+	session.TrackTypeSuzuka:           {Name: "Suzuka", Length: 5807},
+	session.TrackTypeAbuDhabi:         {Name: "Abu Dhabi", Length: 5554},
+	session.TrackTypeTexas:            {Name: "Texas", Length: 5513},
+	session.TrackTypeBrazil:           {Name: "Brazil", Length: 4309},
+	session.TrackTypeAustria:          {Name: "Austria", Length: 4318},
+	session.TrackTypeSochi:            {Name: "Sochi", Length: 5848},
+	session.TrackTypeMexico:           {Name: "Mexico", Length: 4304},
+	session.TrackTypeBaku:             {Name: "Baku (Azerbaijan)", Length: 6003},
+	session.TrackTypeSakhirShort:      {Name: "Sakhir Short"},
+	session.TrackTypeSilverstoneShort: {Name: "Silverstone Short"},
+	session.TrackTypeTexasShort:       {Name: "Texas Short"},
+	session.TrackTypeSuzukaShort:      {Name: "Suzuka Short"},
+}
+
+var tracks2020 = extendTracks(tracks2019, map[session.TrackType]Track{
+	session.TrackTypeHanoi:     {Name: "Hanoi", Length: 5607},
+	session.TrackTypeZandvoort: {Name: "Zandvoort", Length: 4259},
+})
+
+var tracks2021 = extendTracks(tracks2020, map[session.TrackType]Track{
+	session.TrackTypeCatalunya: {Name: "Catalunya", Length: 4675},
+	session.TrackTypeImola:     {Name: "Imola", Length: 4909},
+	session.TrackTypePortimao:  {Name: "Portimão", Length: 4653},
+	session.TrackTypeJeddah:    {Name: "Jeddah", Length: 6174},
+})
+
+var tracks2022 = extendTracks(tracks2021, map[session.TrackType]Track{
+	session.TrackTypeMelbourne: {Name: "Melbourne", Length: 5278},
+	session.TrackTypeAbuDhabi:  {Name: "Abu Dhabi", Length: 5281},
+	session.TrackTypeMiami:     {Name: "Miami", Length: 5412},
+})
+
+// TrackInfo metadata for a track in a packet format
+func TrackInfo(format uint16, track session.TrackType) (Track, bool) {
+	info, ok := tablesFor(format).tracks[track]
+	return info, ok
+}
+
+// extendTracks copy the tracks of an earlier game, adding or replacing tracks
+func extendTracks(base map[session.TrackType]Track, changes map[session.TrackType]Track) map[session.TrackType]Track {
+	out := make(map[session.TrackType]Track, len(base)+len(changes))
+	for id, track := range base {
+		out[id] = track
+	}
+	for id, track := range changes {
+		out[id] = track
+	}
+	return out
+}
@@ -0,0 +1,174 @@
+package receiver
+
+import (
+	"context"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/internal/capture"
+	"net"
+	"sync/atomic"
+	"time"
+)
+
+// LargestPacket size in bytes of the largest packet sent by any supported game, the F1 2020 to 2022 motion packet
+const LargestPacket = 1464
+
+// Options how packets are received
+type Options struct {
+	// BufferSize size in bytes of the buffer each packet is read into, must be larger than LargestPacket.
+	// Packets which fill the whole buffer may have been cut short, so are discarded.
+	BufferSize int
+	// QueueSize number of packets which can be waiting to be handled before new packets are dropped
+	QueueSize int
+}
+
+// Stats counters for the packets received so far
+type Stats struct {
+	// Received packets read from the connection, including dropped and truncated packets
+	Received uint64 `json:"received"`
+	// Bytes read from the connection, including dropped and truncated packets
+	Bytes uint64 `json:"bytes"`
+	// Dropped packets discarded because the queue was full
+	Dropped uint64 `json:"dropped"`
+	// Truncated packets discarded because they filled the whole buffer, so may have been cut short
+	Truncated uint64 `json:"truncated"`
+	// Queued packets waiting to be handled
+	Queued int `json:"queued"`
+	// QueueSize most packets which can be waiting to be handled
+	QueueSize int `json:"queue_size"`
+	// MaxQueued most packets which have been waiting to be handled at once
+	MaxQueued int `json:"max_queued"`
+}
+
+// Receiver reads packets from a connection on a single goroutine into pooled buffers,
+// queueing them to be handled elsewhere. Reads never wait for packets to be handled,
+// packets which arrive while the queue is full are dropped and counted instead.
+type Receiver struct {
+	// Counters are first so they're 64 bit aligned for atomic access on 32 bit platforms
+	received  uint64
+	bytes     uint64
+	dropped   uint64
+	truncated uint64
+	maxQueued int64
+
+	conn    net.PacketConn
+	options Options
+	queue   chan capture.Record
+	// free buffers ready to be read into, released once their packet has been handled
+	free chan []byte
+}
+
+// New creates a receiver reading from the connection, call Run to start receiving
+func New(conn net.PacketConn, options Options) (*Receiver, error) {
+	if options.BufferSize <= LargestPacket {
+		return nil, fmt.Errorf("buffer size %v must be larger than the largest packet, %v bytes", options.BufferSize, LargestPacket)
+	}
+	if options.QueueSize < 1 {
+		return nil, fmt.Errorf("queue size %v must be at least 1", options.QueueSize)
+	}
+	return &Receiver{
+		conn:    conn,
+		options: options,
+		queue:   make(chan capture.Record, options.QueueSize),
+		// Enough buffers for a full queue, the packet being read and the packet being handled
+		free: make(chan []byte, options.QueueSize+2),
+	}, nil
+}
+
+// Run read packets until the context is cancelled or the connection fails, closing the queue
+// when it returns. Cancelling the context returns nil, leaving the connection open.
+func (r *Receiver) Run(ctx context.Context) error {
+	defer close(r.queue)
+
+	stopped := make(chan struct{})
+	defer close(stopped)
+	go func() {
+		select {
+		case <-ctx.Done():
+			// A deadline in the past interrupts a blocked read, and fails any which follow
+			_ = r.conn.SetReadDeadline(time.Now())
+		case <-stopped:
+		}
+	}()
+
+	for {
+		buffer := r.buffer()
+		n, _, err := r.conn.ReadFrom(buffer)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to read connection: %v", err)
+		}
+
+		atomic.AddUint64(&r.received, 1)
+		atomic.AddUint64(&r.bytes, uint64(n))
+		if n == len(buffer) {
+			// The datagram may have been longer than the buffer, and the rest of it is lost
+			atomic.AddUint64(&r.truncated, 1)
+			r.release(buffer)
+			continue
+		}
+
+		record := capture.Record{
+			Received: time.Now(),
+			Data:     buffer[:n],
+		}
+		select {
+		case r.queue <- record:
+			queued := int64(len(r.queue))
+			if queued > atomic.LoadInt64(&r.maxQueued) {
+				// Only this goroutine writes the maximum, so there's no need to compare and swap
+				atomic.StoreInt64(&r.maxQueued, queued)
+			}
+		default:
+			atomic.AddUint64(&r.dropped, 1)
+			r.Release(record)
+		}
+	}
+}
+
+// Records the queue of packets received, closed once Run has returned.
+// Each record's data must be released once it has been handled.
+func (r *Receiver) Records() <-chan capture.Record {
+	return r.queue
+}
+
+// Release return the buffer of a record taken from the queue so it can be reused,
+// the record's data must not be used afterwards
+func (r *Receiver) Release(record capture.Record) {
+	r.release(record.Data)
+}
+
+// release return a buffer so it can be reused, unless it isn't one of the receiver's buffers
+func (r *Receiver) release(data []byte) {
+	if cap(data) != r.options.BufferSize {
+		return
+	}
+	select {
+	case r.free <- data[:cap(data)]:
+	default:
+	}
+}
+
+// buffer a free buffer to read into, allocating one if every buffer is in use
+func (r *Receiver) buffer() []byte {
+	select {
+	case buffer := <-r.free:
+		return buffer
+	default:
+		return make([]byte, r.options.BufferSize)
+	}
+}
+
+// Stats counters for the packets received so far, safe to call while running
+func (r *Receiver) Stats() Stats {
+	return Stats{
+		Received:  atomic.LoadUint64(&r.received),
+		Bytes:     atomic.LoadUint64(&r.bytes),
+		Dropped:   atomic.LoadUint64(&r.dropped),
+		Truncated: atomic.LoadUint64(&r.truncated),
+		Queued:    len(r.queue),
+		QueueSize: cap(r.queue),
+		MaxQueued: int(atomic.LoadInt64(&r.maxQueued)),
+	}
+}
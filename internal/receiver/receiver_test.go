@@ -0,0 +1,216 @@
+package receiver_test
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/roryphillips/f1-telemetry-client/internal/receiver"
+	"net"
+	"testing"
+	"time"
+)
+
+// datagram the datagram for a sequence number, its length and contents both depend on the number
+// so a buffer reused too early shows up as a mismatch
+func datagram(seq uint64) []byte {
+	data := make([]byte, 8+seq%1400)
+	binary.LittleEndian.PutUint64(data, seq)
+	for i := 8; i < len(data); i++ {
+		data[i] = byte(seq + uint64(i))
+	}
+	return data
+}
+
+// valid whether a datagram holds the contents it was sent with
+func valid(data []byte) bool {
+	if len(data) < 8 {
+		return false
+	}
+	seq := binary.LittleEndian.Uint64(data)
+	if len(data) != 8+int(seq%1400) {
+		return false
+	}
+	for i := 8; i < len(data); i++ {
+		if data[i] != byte(seq+uint64(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestReceiver(t *testing.T) {
+	const total = 50000
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer conn.Close()
+	// A small queue and a slow handler make sure buffers are reused, and some packets dropped
+	rec, err := receiver.New(conn, receiver.Options{BufferSize: 2048, QueueSize: 64})
+	if err != nil {
+		t.Fatalf("failed to create receiver: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- rec.Run(ctx)
+	}()
+
+	handled := 0
+	corrupted := 0
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for record := range rec.Records() {
+			if !valid(record.Data) {
+				corrupted++
+			}
+			handled++
+			if handled%5000 == 0 {
+				time.Sleep(20 * time.Millisecond)
+			}
+			rec.Release(record)
+		}
+	}()
+
+	out, err := net.Dial("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer out.Close()
+	for seq := uint64(0); seq < total; seq++ {
+		_, err := out.Write(datagram(seq))
+		if err != nil {
+			t.Fatalf("failed to send datagram %v: %v", seq, err)
+		}
+		// Pace the sender so the socket buffer doesn't overflow before the receiver reads it
+		if seq%50 == 0 {
+			time.Sleep(100 * time.Microsecond)
+		}
+	}
+
+	// Wait for the receiver to read everything which arrived before stopping it
+	received := rec.Stats().Received
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); {
+		time.Sleep(100 * time.Millisecond)
+		next := rec.Stats().Received
+		if next == received {
+			break
+		}
+		received = next
+	}
+	cancel()
+
+	select {
+	case err := <-runErr:
+		if err != nil {
+			t.Errorf("run returned %v after cancelling", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("run didn't return after cancelling")
+	}
+	<-done
+
+	stats := rec.Stats()
+	t.Logf("%+v, handled %v", stats, handled)
+	if stats.Received == 0 || stats.Received > total {
+		t.Errorf("received %v of %v datagrams", stats.Received, total)
+	}
+	if stats.Received != uint64(handled)+stats.Dropped {
+		t.Errorf("received %v datagrams, but handled %v and dropped %v", stats.Received, handled, stats.Dropped)
+	}
+	if corrupted != 0 {
+		t.Errorf("%v of %v datagrams handled were corrupted", corrupted, handled)
+	}
+	if stats.Truncated != 0 {
+		t.Errorf("%v datagrams were truncated", stats.Truncated)
+	}
+	if stats.MaxQueued > stats.QueueSize {
+		t.Errorf("queued %v datagrams, more than the queue size %v", stats.MaxQueued, stats.QueueSize)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer conn.Close()
+
+	tests := []struct {
+		options receiver.Options
+		valid   bool
+	}{
+		{receiver.Options{BufferSize: receiver.LargestPacket + 1, QueueSize: 1}, true},
+		{receiver.Options{BufferSize: 2048, QueueSize: 1024}, true},
+		// A buffer the size of the largest packet would discard every one of them as truncated
+		{receiver.Options{BufferSize: receiver.LargestPacket, QueueSize: 1}, false},
+		{receiver.Options{BufferSize: 1024, QueueSize: 1}, false},
+		{receiver.Options{BufferSize: 0, QueueSize: 1}, false},
+		{receiver.Options{BufferSize: 2048, QueueSize: 0}, false},
+	}
+	for _, test := range tests {
+		_, err := receiver.New(conn, test.options)
+		if (err == nil) != test.valid {
+			t.Errorf("%+v: New returned %v, want valid %v", test.options, err, test.valid)
+		}
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer conn.Close()
+	rec, err := receiver.New(conn, receiver.Options{BufferSize: receiver.LargestPacket + 1, QueueSize: 8})
+	if err != nil {
+		t.Fatalf("failed to create receiver: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = rec.Run(ctx)
+	}()
+
+	out, err := net.Dial("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer out.Close()
+	// Datagrams which fit, fill the buffer exactly, and are cut short, told apart by their first byte
+	sizes := []int{receiver.LargestPacket, receiver.LargestPacket + 1, 1, 4000, 2}
+	for i, size := range sizes {
+		data := make([]byte, size)
+		data[0] = byte(i)
+		_, err := out.Write(data)
+		if err != nil {
+			t.Fatalf("failed to send datagram %v: %v", i, err)
+		}
+	}
+
+	// Only the datagrams which fit are queued, in the order they were sent
+	for _, want := range []int{0, 2, 4} {
+		select {
+		case record := <-rec.Records():
+			if len(record.Data) != sizes[want] || record.Data[0] != byte(want) {
+				t.Errorf("received %v bytes starting %v, want datagram %v of %v bytes", len(record.Data), record.Data[0], want, sizes[want])
+			}
+			rec.Release(record)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("datagram %v wasn't received", want)
+		}
+	}
+	cancel()
+	for range rec.Records() {
+		t.Errorf("a truncated datagram was queued")
+	}
+
+	stats := rec.Stats()
+	if stats.Received != 5 || stats.Truncated != 2 || stats.Dropped != 0 {
+		t.Errorf("received %v, truncated %v and dropped %v datagrams, want 5, 2 and 0", stats.Received, stats.Truncated, stats.Dropped)
+	}
+}
@@ -0,0 +1,263 @@
+package stats
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/internal/capture"
+	"github.com/roryphillips/f1-telemetry-client/internal/receiver"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/registry"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// PacketStats counters for the packets of a single type, or of every type
+type PacketStats struct {
+	// Packets number of packets received
+	Packets uint64 `json:"packets"`
+	// Bytes number of bytes received
+	Bytes uint64 `json:"bytes"`
+	// PacketsPerSecond packets received in the last whole second
+	PacketsPerSecond uint64 `json:"packets_per_second"`
+	// BytesPerSecond bytes received in the last whole second
+	BytesPerSecond uint64 `json:"bytes_per_second"`
+	// FrameGaps times the frame identifier skipped ahead by at least twice its usual step,
+	// meaning packets were lost in between
+	FrameGaps uint64 `json:"frame_gaps"`
+	// MissedFrames estimated number of packets lost in frame gaps
+	MissedFrames uint64 `json:"missed_frames"`
+	// OutOfOrder packets with an earlier frame identifier than a packet of the same type before them,
+	// including the first packet after a flashback before F1 2023
+	OutOfOrder uint64 `json:"out_of_order"`
+	// DecodeErrors packets which couldn't be decoded, including types which aren't registered
+	DecodeErrors uint64 `json:"decode_errors"`
+}
+
+// add the counters of other to these
+func (s *PacketStats) add(other PacketStats) {
+	s.Packets += other.Packets
+	s.Bytes += other.Bytes
+	s.PacketsPerSecond += other.PacketsPerSecond
+	s.BytesPerSecond += other.BytesPerSecond
+	s.FrameGaps += other.FrameGaps
+	s.MissedFrames += other.MissedFrames
+	s.OutOfOrder += other.OutOfOrder
+	s.DecodeErrors += other.DecodeErrors
+}
+
+// Snapshot the statistics at a point in time
+type Snapshot struct {
+	// Time when the snapshot was taken
+	Time time.Time `json:"time"`
+	// Packets counters per packet type
+	Packets map[common.PacketID]PacketStats `json:"packets"`
+	// Total counters for every packet type
+	Total PacketStats `json:"total"`
+	// HeaderErrors packets whose header couldn't be decoded, so aren't counted against a packet type
+	HeaderErrors uint64 `json:"header_errors"`
+	// Receiver counters from the receiver, including the queue depth and dropped packets
+	Receiver receiver.Stats `json:"receiver"`
+}
+
+// String the snapshot as a table, a row per packet type followed by the totals and the queue
+func (s Snapshot) String() string {
+	ids := make([]common.PacketID, 0, len(s.Packets))
+	for id := range s.Packets {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	var out strings.Builder
+	w := tabwriter.NewWriter(&out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "type\tpackets\tpackets/s\tbytes/s\tgaps\tmissed\tout of order\tdecode errors\t")
+	row := func(name string, p PacketStats) {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t%v\t%v\t%v\t\n", name, p.Packets, p.PacketsPerSecond, p.BytesPerSecond,
+			p.FrameGaps, p.MissedFrames, p.OutOfOrder, p.DecodeErrors)
+	}
+	for _, id := range ids {
+		row(id.String(), s.Packets[id])
+	}
+	row("total", s.Total)
+	_ = w.Flush()
+
+	fmt.Fprintf(&out, "queue %v/%v, at most %v, %v dropped, %v truncated, %v header errors",
+		s.Receiver.Queued, s.Receiver.QueueSize, s.Receiver.MaxQueued, s.Receiver.Dropped, s.Receiver.Truncated, s.HeaderErrors)
+	return out.String()
+}
+
+// window packets received within a single second
+type window struct {
+	second  int64
+	packets uint64
+	bytes   uint64
+}
+
+// counter running statistics for a packet type
+type counter struct {
+	stats PacketStats
+	// current and previous windows the rates are measured over
+	current  window
+	previous window
+	// sessionUID and lastFrame of the latest packet, frames are only compared within a session
+	sessionUID uint64
+	lastFrame  uint32
+	seen       bool
+	// step smallest increase in frame identifier seen between packets, the rate the type is sent at
+	step uint32
+}
+
+// Collector gathers statistics on the packets received, safe for concurrent use
+type Collector struct {
+	receiver *receiver.Receiver
+	lock     *sync.Mutex
+	counters map[common.PacketID]*counter
+	// headerErrors packets whose header couldn't be decoded
+	headerErrors uint64
+}
+
+// New creates a collector, including the queue and drops of the receiver in its snapshots if it's not nil
+func New(rec *receiver.Receiver) *Collector {
+	return &Collector{
+		receiver: rec,
+		lock:     &sync.Mutex{},
+		counters: make(map[common.PacketID]*counter),
+	}
+}
+
+// Observe count a packet given the header and error returned by decoding it, i.e. with registry.Decode.
+// Decoding is left to the caller, which usually needs the packet anyway, so each packet is only decoded once.
+func (c *Collector) Observe(record capture.Record, header common.Header, decodeErr error) {
+	if errors.Is(decodeErr, registry.ErrInvalidHeader) || errors.Is(decodeErr, registry.ErrUnsupportedFormat) {
+		c.lock.Lock()
+		c.headerErrors++
+		c.lock.Unlock()
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	count, ok := c.counters[header.PacketID]
+	if !ok {
+		count = &counter{}
+		c.counters[header.PacketID] = count
+	}
+	count.stats.Packets++
+	count.stats.Bytes += uint64(len(record.Data))
+	if decodeErr != nil {
+		count.stats.DecodeErrors++
+	}
+
+	second := record.Received.Unix()
+	if second != count.current.second {
+		if second == count.current.second+1 {
+			count.previous = count.current
+		} else {
+			count.previous = window{}
+		}
+		count.current = window{second: second}
+	}
+	count.current.packets++
+	count.current.bytes += uint64(len(record.Data))
+
+	// Events and the final classification are only sent when something happens, so have no regular frame step
+	if header.PacketID == common.PacketIDEvent || header.PacketID == common.PacketIDFinalClassification {
+		return
+	}
+	count.observeFrame(header)
+}
+
+// reorderFrames how far back a frame identifier can go and still be a packet arriving late,
+// rather than the game going back to an earlier frame
+const reorderFrames = 10
+
+// observeFrame check the frame identifier follows on from the last packet of the type
+func (c *counter) observeFrame(header common.Header) {
+	frame := header.FrameIdentifier
+	// The frame identifier goes back after a flashback, the overall frame identifier doesn't
+	if header.PacketFormat >= common.PacketFormat2023 {
+		frame = header.OverallFrameIdentifier
+	}
+	if !c.seen || header.SessionUID != c.sessionUID {
+		c.seen = true
+		c.sessionUID = header.SessionUID
+		c.lastFrame = frame
+		c.step = 0
+		return
+	}
+
+	switch {
+	case frame < c.lastFrame:
+		c.stats.OutOfOrder++
+		window := uint32(reorderFrames)
+		if 2*c.step > window {
+			window = 2 * c.step
+		}
+		// A flashback or a restarted session carries on from the earlier frame, so follow on from it
+		if c.lastFrame-frame > window {
+			c.lastFrame = frame
+			c.step = 0
+		}
+		return
+	case frame == c.lastFrame:
+		return
+	}
+	diff := frame - c.lastFrame
+	c.lastFrame = frame
+	if c.step == 0 || diff < c.step {
+		c.step = diff
+		return
+	}
+	if diff >= 2*c.step {
+		c.stats.FrameGaps++
+		c.stats.MissedFrames += uint64(diff/c.step - 1)
+	}
+}
+
+// Snapshot the statistics so far, rates are for the last whole second before now
+func (c *Collector) Snapshot() Snapshot {
+	now := time.Now()
+	snapshot := Snapshot{
+		Time:    now,
+		Packets: make(map[common.PacketID]PacketStats),
+	}
+	if c.receiver != nil {
+		snapshot.Receiver = c.receiver.Stats()
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	snapshot.HeaderErrors = c.headerErrors
+	last := now.Unix() - 1
+	for id, count := range c.counters {
+		stats := count.stats
+		for _, w := range []window{count.current, count.previous} {
+			if w.second == last {
+				stats.PacketsPerSecond = w.packets
+				stats.BytesPerSecond = w.bytes
+			}
+		}
+		snapshot.Packets[id] = stats
+		snapshot.Total.add(stats)
+	}
+	return snapshot
+}
+
+// ServeHTTP respond with a snapshot as JSON
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, err := json.Marshal(c.Snapshot())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
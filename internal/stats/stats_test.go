@@ -0,0 +1,158 @@
+package stats_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/internal/capture"
+	"github.com/roryphillips/f1-telemetry-client/internal/stats"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/registry"
+	"testing"
+	"time"
+)
+
+// packet the header fields the frame checks depend on
+type packet struct {
+	sessionUID uint64
+	frame      uint32
+	// overall the overall frame identifier, only sent from F1 2023
+	overall uint32
+}
+
+// observe count motion packets of a format, received a frame apart
+func observe(collector *stats.Collector, format uint16, packets []packet) {
+	received := time.Unix(1626616985, 0)
+	for _, p := range packets {
+		header := common.Header{
+			PacketFormat:           format,
+			PacketID:               common.PacketIDMotion,
+			SessionUID:             p.sessionUID,
+			FrameIdentifier:        p.frame,
+			OverallFrameIdentifier: p.overall,
+		}
+		collector.Observe(capture.Record{Received: received, Data: make([]byte, 100)}, header, nil)
+		received = received.Add(time.Second / 60)
+	}
+}
+
+func TestFrames(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  uint16
+		packets []packet
+		want    stats.PacketStats
+	}{
+		{
+			name:    "in order",
+			format:  common.PacketFormat2022,
+			packets: []packet{{1, 100, 0}, {1, 101, 0}, {1, 102, 0}, {1, 103, 0}},
+		},
+		{
+			name:    "repeated frame",
+			format:  common.PacketFormat2022,
+			packets: []packet{{1, 100, 0}, {1, 101, 0}, {1, 101, 0}, {1, 102, 0}},
+		},
+		{
+			name:    "gap",
+			format:  common.PacketFormat2022,
+			packets: []packet{{1, 100, 0}, {1, 102, 0}, {1, 104, 0}, {1, 110, 0}, {1, 112, 0}},
+			want:    stats.PacketStats{FrameGaps: 1, MissedFrames: 2},
+		},
+		{
+			// The late packet leaves a gap before it, and doesn't move the frames on
+			name:    "out of order",
+			format:  common.PacketFormat2022,
+			packets: []packet{{1, 100, 0}, {1, 102, 0}, {1, 106, 0}, {1, 104, 0}, {1, 108, 0}, {1, 110, 0}},
+			want:    stats.PacketStats{FrameGaps: 1, MissedFrames: 1, OutOfOrder: 1},
+		},
+		{
+			name:    "flashback",
+			format:  common.PacketFormat2022,
+			packets: []packet{{1, 500, 0}, {1, 501, 0}, {1, 502, 0}, {1, 100, 0}, {1, 101, 0}, {1, 102, 0}, {1, 103, 0}},
+			want:    stats.PacketStats{OutOfOrder: 1},
+		},
+		{
+			// The step is measured again after a flashback, rather than kept from before it
+			name:    "gap after flashback",
+			format:  common.PacketFormat2022,
+			packets: []packet{{1, 500, 0}, {1, 501, 0}, {1, 100, 0}, {1, 102, 0}, {1, 104, 0}, {1, 110, 0}},
+			want:    stats.PacketStats{OutOfOrder: 1, FrameGaps: 1, MissedFrames: 2},
+		},
+		{
+			name:    "2023 flashback",
+			format:  common.PacketFormat2023,
+			packets: []packet{{1, 500, 500}, {1, 501, 501}, {1, 502, 502}, {1, 100, 503}, {1, 101, 504}, {1, 102, 505}},
+		},
+		{
+			name:    "2023 gap",
+			format:  common.PacketFormat2023,
+			packets: []packet{{1, 100, 600}, {1, 101, 601}, {1, 102, 602}, {1, 103, 606}, {1, 104, 607}},
+			want:    stats.PacketStats{FrameGaps: 1, MissedFrames: 3},
+		},
+		{
+			name:    "new session",
+			format:  common.PacketFormat2022,
+			packets: []packet{{1, 500, 0}, {1, 501, 0}, {1, 502, 0}, {2, 0, 0}, {2, 1, 0}, {2, 2, 0}},
+		},
+	}
+
+	for _, test := range tests {
+		collector := stats.New(nil)
+		observe(collector, test.format, test.packets)
+		snapshot := collector.Snapshot()
+		got := snapshot.Packets[common.PacketIDMotion]
+		if got.Packets != uint64(len(test.packets)) || got.Bytes != uint64(100*len(test.packets)) {
+			t.Errorf("%v: counted %v packets of %v bytes, want %v", test.name, got.Packets, got.Bytes, len(test.packets))
+		}
+		if got.FrameGaps != test.want.FrameGaps || got.MissedFrames != test.want.MissedFrames || got.OutOfOrder != test.want.OutOfOrder {
+			t.Errorf("%v: %v gaps, %v missed and %v out of order, want %v, %v and %v", test.name,
+				got.FrameGaps, got.MissedFrames, got.OutOfOrder, test.want.FrameGaps, test.want.MissedFrames, test.want.OutOfOrder)
+		}
+		if snapshot.Total != got {
+			t.Errorf("%v: total %+v, want %+v", test.name, snapshot.Total, got)
+		}
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	collector := stats.New(nil)
+	received := time.Unix(1626616985, 0)
+	record := capture.Record{Received: received, Data: make([]byte, 24)}
+	header := common.Header{PacketFormat: common.PacketFormat2022, PacketID: common.PacketIDLapData, SessionUID: 1, FrameIdentifier: 10}
+
+	collector.Observe(record, header, nil)
+	// A packet of a known type which can't be decoded is counted against its type
+	collector.Observe(record, header, errors.New("failed to decode packet"))
+	// A packet whose header can't be decoded has no type to count it against
+	collector.Observe(record, common.Header{}, fmt.Errorf("short packet: %w", registry.ErrInvalidHeader))
+	collector.Observe(record, common.Header{}, fmt.Errorf("format 2018: %w", registry.ErrUnsupportedFormat))
+
+	snapshot := collector.Snapshot()
+	if snapshot.HeaderErrors != 2 {
+		t.Errorf("%v header errors, want 2", snapshot.HeaderErrors)
+	}
+	if len(snapshot.Packets) != 1 {
+		t.Errorf("counted packets of %v types, want only lap data", len(snapshot.Packets))
+	}
+	got := snapshot.Packets[common.PacketIDLapData]
+	if got.Packets != 2 || got.DecodeErrors != 1 {
+		t.Errorf("%v lap data packets with %v decode errors, want 2 and 1", got.Packets, got.DecodeErrors)
+	}
+	if snapshot.Total.Packets != 2 || snapshot.Total.DecodeErrors != 1 {
+		t.Errorf("%v packets in total with %v decode errors, want 2 and 1", snapshot.Total.Packets, snapshot.Total.DecodeErrors)
+	}
+}
+
+func TestEventsHaveNoFrames(t *testing.T) {
+	collector := stats.New(nil)
+	received := time.Unix(1626616985, 0)
+	for _, frame := range []uint32{100, 50, 900, 10} {
+		header := common.Header{PacketFormat: common.PacketFormat2022, PacketID: common.PacketIDEvent, SessionUID: 1, FrameIdentifier: frame}
+		collector.Observe(capture.Record{Received: received, Data: make([]byte, 40)}, header, nil)
+	}
+
+	got := collector.Snapshot().Packets[common.PacketIDEvent]
+	if got.Packets != 4 || got.FrameGaps != 0 || got.OutOfOrder != 0 {
+		t.Errorf("%v events with %v gaps and %v out of order, want 4, 0 and 0", got.Packets, got.FrameGaps, got.OutOfOrder)
+	}
+}
@@ -0,0 +1,79 @@
+package car_damage
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet car damage data, sent from F1 2021 onwards
+type Packet struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// CarDamage damage for all cars on track
+	CarDamage [22]CarDamageData `json:"car_damage" packet:"0"`
+}
+
+// CarDamageData per-car damage data
+type CarDamageData struct {
+	// TyresWear tyre wear percentage
+	TyresWear WheelDataFloat `json:"tyres_wear" packet:"0"`
+	// TyresDamage tyre damage percentage
+	TyresDamage WheelDataUInt8 `json:"tyres_damage" packet:"1"`
+	// BrakesDamage brake damage percentage
+	BrakesDamage WheelDataUInt8 `json:"brakes_damage" packet:"2"`
+	// FrontLeftWingDamage front left wing damage percentage
+	FrontLeftWingDamage uint8 `json:"front_left_wing_damage" packet:"3"`
+	// FrontRightWingDamage front right wing damage percentage
+	FrontRightWingDamage uint8 `json:"front_right_wing_damage" packet:"4"`
+	// RearWingDamage rear wing damage percentage
+	RearWingDamage uint8 `json:"rear_wing_damage" packet:"5"`
+	// FloorDamage floor damage percentage
+	FloorDamage uint8 `json:"floor_damage" packet:"6"`
+	// DiffuserDamage diffuser damage percentage
+	DiffuserDamage uint8 `json:"diffuser_damage" packet:"7"`
+	// SidepodDamage sidepod damage percentage
+	SidepodDamage uint8 `json:"sidepod_damage" packet:"8"`
+	// DRSFault whether DRS has a fault
+	DRSFault bool `json:"drs_fault" packet:"9"`
+	// GearBoxDamage gear box damage percentage
+	GearBoxDamage uint8 `json:"gear_box_damage" packet:"10"`
+	// EngineDamage engine damage percentage
+	EngineDamage uint8 `json:"engine_damage" packet:"11"`
+	// EngineMGUHWear MGU-H wear percentage
+	EngineMGUHWear uint8 `json:"engine_mguh_wear" packet:"12"`
+	// EngineESWear energy store wear percentage
+	EngineESWear uint8 `json:"engine_es_wear" packet:"13"`
+	// EngineCEWear control electronics wear percentage
+	EngineCEWear uint8 `json:"engine_ce_wear" packet:"14"`
+	// EngineICEWear internal combustion engine wear percentage
+	EngineICEWear uint8 `json:"engine_ice_wear" packet:"15"`
+	// EngineMGUKWear MGU-K wear percentage
+	EngineMGUKWear uint8 `json:"engine_mguk_wear" packet:"16"`
+	// EngineTCWear turbo charger wear percentage
+	EngineTCWear uint8 `json:"engine_tc_wear" packet:"17"`
+}
+
+// WheelDataFloat float data associated with all wheels on the car
+type WheelDataFloat struct {
+	// RearLeft Rear left wheel
+	RearLeft float32 `json:"rear_left" packet:"0"`
+	// RearRight Rear right wheel
+	RearRight float32 `json:"rear_right" packet:"1"`
+	// FrontLeft Front left wheel
+	FrontLeft float32 `json:"front_left" packet:"2"`
+	// FrontRight Front right wheel
+	FrontRight float32 `json:"front_right" packet:"3"`
+}
+
+// WheelDataUInt8 uint8 data associated with all wheels on the car
+type WheelDataUInt8 struct {
+	// RearLeft Rear left wheel
+	RearLeft uint8 `json:"rear_left" packet:"0"`
+	// RearRight Rear right wheel
+	RearRight uint8 `json:"rear_right" packet:"1"`
+	// FrontLeft Front left wheel
+	FrontLeft uint8 `json:"front_left" packet:"2"`
+	// FrontRight Front right wheel
+	FrontRight uint8 `json:"front_right" packet:"3"`
+}
@@ -0,0 +1,59 @@
+package car_damage
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet2022 F1 2022 car damage data
+type Packet2022 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// CarDamage damage for all cars on track
+	CarDamage [22]CarDamageData2022 `json:"car_damage" packet:"0"`
+}
+
+// CarDamageData2022 F1 2022 per-car damage data
+type CarDamageData2022 struct {
+	// TyresWear tyre wear percentage
+	TyresWear WheelDataFloat `json:"tyres_wear" packet:"0"`
+	// TyresDamage tyre damage percentage
+	TyresDamage WheelDataUInt8 `json:"tyres_damage" packet:"1"`
+	// BrakesDamage brake damage percentage
+	BrakesDamage WheelDataUInt8 `json:"brakes_damage" packet:"2"`
+	// FrontLeftWingDamage front left wing damage percentage
+	FrontLeftWingDamage uint8 `json:"front_left_wing_damage" packet:"3"`
+	// FrontRightWingDamage front right wing damage percentage
+	FrontRightWingDamage uint8 `json:"front_right_wing_damage" packet:"4"`
+	// RearWingDamage rear wing damage percentage
+	RearWingDamage uint8 `json:"rear_wing_damage" packet:"5"`
+	// FloorDamage floor damage percentage
+	FloorDamage uint8 `json:"floor_damage" packet:"6"`
+	// DiffuserDamage diffuser damage percentage
+	DiffuserDamage uint8 `json:"diffuser_damage" packet:"7"`
+	// SidepodDamage sidepod damage percentage
+	SidepodDamage uint8 `json:"sidepod_damage" packet:"8"`
+	// DRSFault whether DRS has a fault
+	DRSFault bool `json:"drs_fault" packet:"9"`
+	// ERSFault whether ERS has a fault
+	ERSFault bool `json:"ers_fault" packet:"10"`
+	// GearBoxDamage gear box damage percentage
+	GearBoxDamage uint8 `json:"gear_box_damage" packet:"11"`
+	// EngineDamage engine damage percentage
+	EngineDamage uint8 `json:"engine_damage" packet:"12"`
+	// EngineMGUHWear MGU-H wear percentage
+	EngineMGUHWear uint8 `json:"engine_mguh_wear" packet:"13"`
+	// EngineESWear energy store wear percentage
+	EngineESWear uint8 `json:"engine_es_wear" packet:"14"`
+	// EngineCEWear control electronics wear percentage
+	EngineCEWear uint8 `json:"engine_ce_wear" packet:"15"`
+	// EngineICEWear internal combustion engine wear percentage
+	EngineICEWear uint8 `json:"engine_ice_wear" packet:"16"`
+	// EngineMGUKWear MGU-K wear percentage
+	EngineMGUKWear uint8 `json:"engine_mguk_wear" packet:"17"`
+	// EngineTCWear turbo charger wear percentage
+	EngineTCWear uint8 `json:"engine_tc_wear" packet:"18"`
+	// EngineBlown whether the engine has blown
+	EngineBlown bool `json:"engine_blown" packet:"19"`
+	// EngineSeized whether the engine has seized
+	EngineSeized bool `json:"engine_seized" packet:"20"`
+}
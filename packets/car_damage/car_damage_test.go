@@ -0,0 +1,99 @@
+package car_damage_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/car_damage"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"testing"
+)
+
+// tyres append the tyre and brake damage of car i, which every format starts with
+func tyres(b *packettest.Builder, i int) {
+	b.F32(10.5).F32(11.5).F32(12.5).F32(float32(i) + 0.75)
+	b.U8(1).U8(2).U8(3).U8(uint8(4 + i))
+	b.U8(5).U8(6).U8(7).U8(uint8(8 + i))
+	b.U8(10).U8(11).U8(12).U8(13).U8(14).U8(uint8(15 + i))
+}
+
+// wear append the gearbox, engine and engine part wear of car i, which every format has after the faults
+func wear(b *packettest.Builder, i int) {
+	b.U8(20).U8(21).U8(22).U8(23).U8(24).U8(25).U8(26).U8(uint8(27 + i))
+}
+
+func TestGolden2021(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2021, common.PacketIDCarDamage)
+	b := &packettest.Builder{}
+	for i := 0; i < 22; i++ {
+		tyres(b, i)
+		b.Bool(i == 9)
+		wear(b, i)
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*car_damage.Packet)
+	for i, damage := range p.CarDamage {
+		want := car_damage.CarDamageData{
+			TyresWear:            car_damage.WheelDataFloat{RearLeft: 10.5, RearRight: 11.5, FrontLeft: 12.5, FrontRight: float32(i) + 0.75},
+			TyresDamage:          car_damage.WheelDataUInt8{RearLeft: 1, RearRight: 2, FrontLeft: 3, FrontRight: uint8(4 + i)},
+			BrakesDamage:         car_damage.WheelDataUInt8{RearLeft: 5, RearRight: 6, FrontLeft: 7, FrontRight: uint8(8 + i)},
+			FrontLeftWingDamage:  10,
+			FrontRightWingDamage: 11,
+			RearWingDamage:       12,
+			FloorDamage:          13,
+			DiffuserDamage:       14,
+			SidepodDamage:        uint8(15 + i),
+			DRSFault:             i == 9,
+			GearBoxDamage:        20,
+			EngineDamage:         21,
+			EngineMGUHWear:       22,
+			EngineESWear:         23,
+			EngineCEWear:         24,
+			EngineICEWear:        25,
+			EngineMGUKWear:       26,
+			EngineTCWear:         uint8(27 + i),
+		}
+		if damage != want {
+			t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, damage, want)
+		}
+	}
+}
+
+func TestGolden2022(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2022, common.PacketIDCarDamage)
+	b := &packettest.Builder{}
+	for i := 0; i < 22; i++ {
+		tyres(b, i)
+		b.Bool(i == 9).Bool(i == 10)
+		wear(b, i)
+		b.Bool(i == 11).Bool(i == 12)
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*car_damage.Packet2022)
+	for i, damage := range p.CarDamage {
+		want := car_damage.CarDamageData2022{
+			TyresWear:            car_damage.WheelDataFloat{RearLeft: 10.5, RearRight: 11.5, FrontLeft: 12.5, FrontRight: float32(i) + 0.75},
+			TyresDamage:          car_damage.WheelDataUInt8{RearLeft: 1, RearRight: 2, FrontLeft: 3, FrontRight: uint8(4 + i)},
+			BrakesDamage:         car_damage.WheelDataUInt8{RearLeft: 5, RearRight: 6, FrontLeft: 7, FrontRight: uint8(8 + i)},
+			FrontLeftWingDamage:  10,
+			FrontRightWingDamage: 11,
+			RearWingDamage:       12,
+			FloorDamage:          13,
+			DiffuserDamage:       14,
+			SidepodDamage:        uint8(15 + i),
+			DRSFault:             i == 9,
+			ERSFault:             i == 10,
+			GearBoxDamage:        20,
+			EngineDamage:         21,
+			EngineMGUHWear:       22,
+			EngineESWear:         23,
+			EngineCEWear:         24,
+			EngineICEWear:        25,
+			EngineMGUKWear:       26,
+			EngineTCWear:         uint8(27 + i),
+			EngineBlown:          i == 11,
+			EngineSeized:         i == 12,
+		}
+		if damage != want {
+			t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, damage, want)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package car_damage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// DecodeFrom decode CarDamageData from its wire format, returning the number of bytes read
+func (p *CarDamageData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 39 {
+		return 0, fmt.Errorf("unable to decode CarDamageData: need 39 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 39, nil
+}
+
+// PacketSize size of CarDamageData on the wire in bytes
+func (p *CarDamageData) PacketSize() int {
+	return 39
+}
+
+// decode decode CarDamageData from data holding at least 39 bytes
+func (p *CarDamageData) decode(data []byte) error {
+	if err := p.TyresWear.decode(data[0:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresWear: %v", err)
+	}
+	if err := p.TyresDamage.decode(data[16:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresDamage: %v", err)
+	}
+	if err := p.BrakesDamage.decode(data[20:]); err != nil {
+		return fmt.Errorf("unable to set struct field BrakesDamage: %v", err)
+	}
+	p.FrontLeftWingDamage = uint8(data[24])
+	p.FrontRightWingDamage = uint8(data[25])
+	p.RearWingDamage = uint8(data[26])
+	p.FloorDamage = uint8(data[27])
+	p.DiffuserDamage = uint8(data[28])
+	p.SidepodDamage = uint8(data[29])
+	if data[30] > 1 {
+		return fmt.Errorf("failed to set DRSFault value: unexpected byte value %v is <0 or >1", data[30])
+	}
+	p.DRSFault = bool(data[30] == 1)
+	p.GearBoxDamage = uint8(data[31])
+	p.EngineDamage = uint8(data[32])
+	p.EngineMGUHWear = uint8(data[33])
+	p.EngineESWear = uint8(data[34])
+	p.EngineCEWear = uint8(data[35])
+	p.EngineICEWear = uint8(data[36])
+	p.EngineMGUKWear = uint8(data[37])
+	p.EngineTCWear = uint8(data[38])
+	return nil
+}
+
+// DecodeFrom decode CarDamageData2022 from its wire format, returning the number of bytes read
+func (p *CarDamageData2022) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 42 {
+		return 0, fmt.Errorf("unable to decode CarDamageData2022: need 42 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 42, nil
+}
+
+// PacketSize size of CarDamageData2022 on the wire in bytes
+func (p *CarDamageData2022) PacketSize() int {
+	return 42
+}
+
+// decode decode CarDamageData2022 from data holding at least 42 bytes
+func (p *CarDamageData2022) decode(data []byte) error {
+	if err := p.TyresWear.decode(data[0:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresWear: %v", err)
+	}
+	if err := p.TyresDamage.decode(data[16:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresDamage: %v", err)
+	}
+	if err := p.BrakesDamage.decode(data[20:]); err != nil {
+		return fmt.Errorf("unable to set struct field BrakesDamage: %v", err)
+	}
+	p.FrontLeftWingDamage = uint8(data[24])
+	p.FrontRightWingDamage = uint8(data[25])
+	p.RearWingDamage = uint8(data[26])
+	p.FloorDamage = uint8(data[27])
+	p.DiffuserDamage = uint8(data[28])
+	p.SidepodDamage = uint8(data[29])
+	if data[30] > 1 {
+		return fmt.Errorf("failed to set DRSFault value: unexpected byte value %v is <0 or >1", data[30])
+	}
+	p.DRSFault = bool(data[30] == 1)
+	if data[31] > 1 {
+		return fmt.Errorf("failed to set ERSFault value: unexpected byte value %v is <0 or >1", data[31])
+	}
+	p.ERSFault = bool(data[31] == 1)
+	p.GearBoxDamage = uint8(data[32])
+	p.EngineDamage = uint8(data[33])
+	p.EngineMGUHWear = uint8(data[34])
+	p.EngineESWear = uint8(data[35])
+	p.EngineCEWear = uint8(data[36])
+	p.EngineICEWear = uint8(data[37])
+	p.EngineMGUKWear = uint8(data[38])
+	p.EngineTCWear = uint8(data[39])
+	if data[40] > 1 {
+		return fmt.Errorf("failed to set EngineBlown value: unexpected byte value %v is <0 or >1", data[40])
+	}
+	p.EngineBlown = bool(data[40] == 1)
+	if data[41] > 1 {
+		return fmt.Errorf("failed to set EngineSeized value: unexpected byte value %v is <0 or >1", data[41])
+	}
+	p.EngineSeized = bool(data[41] == 1)
+	return nil
+}
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 858 {
+		return 0, fmt.Errorf("unable to decode Packet: need 858 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 858, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 858
+}
+
+// decode decode Packet from data holding at least 858 bytes
+func (p *Packet) decode(data []byte) error {
+	for i0 := range p.CarDamage {
+		if err := p.CarDamage[i0].decode(data[0+i0*39:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarDamage item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2022 from its wire format, returning the number of bytes read
+func (p *Packet2022) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 924 {
+		return 0, fmt.Errorf("unable to decode Packet2022: need 924 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 924, nil
+}
+
+// PacketSize size of Packet2022 on the wire in bytes
+func (p *Packet2022) PacketSize() int {
+	return 924
+}
+
+// decode decode Packet2022 from data holding at least 924 bytes
+func (p *Packet2022) decode(data []byte) error {
+	for i0 := range p.CarDamage {
+		if err := p.CarDamage[i0].decode(data[0+i0*42:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarDamage item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode WheelDataFloat from its wire format, returning the number of bytes read
+func (p *WheelDataFloat) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 16 {
+		return 0, fmt.Errorf("unable to decode WheelDataFloat: need 16 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 16, nil
+}
+
+// PacketSize size of WheelDataFloat on the wire in bytes
+func (p *WheelDataFloat) PacketSize() int {
+	return 16
+}
+
+// decode decode WheelDataFloat from data holding at least 16 bytes
+func (p *WheelDataFloat) decode(data []byte) error {
+	p.RearLeft = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[0:])))
+	p.RearRight = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[4:])))
+	p.FrontLeft = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[8:])))
+	p.FrontRight = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[12:])))
+	return nil
+}
+
+// DecodeFrom decode WheelDataUInt8 from its wire format, returning the number of bytes read
+func (p *WheelDataUInt8) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, fmt.Errorf("unable to decode WheelDataUInt8: need 4 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 4, nil
+}
+
+// PacketSize size of WheelDataUInt8 on the wire in bytes
+func (p *WheelDataUInt8) PacketSize() int {
+	return 4
+}
+
+// decode decode WheelDataUInt8 from data holding at least 4 bytes
+func (p *WheelDataUInt8) decode(data []byte) error {
+	p.RearLeft = uint8(data[0])
+	p.RearRight = uint8(data[1])
+	p.FrontLeft = uint8(data[2])
+	p.FrontRight = uint8(data[3])
+	return nil
+}
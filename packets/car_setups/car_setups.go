@@ -0,0 +1,63 @@
+package car_setups
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet car setups data
+type Packet struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// CarSetups setups for all cars on track
+	CarSetups [22]CarSetupData `json:"car_setups" packet:"0"`
+}
+
+// CarSetupData per-car setup data
+type CarSetupData struct {
+	// FrontWing front wing aero
+	FrontWing uint8 `json:"front_wing" packet:"0"`
+	// RearWing rear wing aero
+	RearWing uint8 `json:"rear_wing" packet:"1"`
+	// OnThrottle differential adjustment on throttle (percentage)
+	OnThrottle uint8 `json:"on_throttle" packet:"2"`
+	// OffThrottle differential adjustment off throttle (percentage)
+	OffThrottle uint8 `json:"off_throttle" packet:"3"`
+	// FrontCamber front camber angle (suspension geometry)
+	FrontCamber float32 `json:"front_camber" packet:"4"`
+	// RearCamber rear camber angle (suspension geometry)
+	RearCamber float32 `json:"rear_camber" packet:"5"`
+	// FrontToe front toe angle (suspension geometry)
+	FrontToe float32 `json:"front_toe" packet:"6"`
+	// RearToe rear toe angle (suspension geometry)
+	RearToe float32 `json:"rear_toe" packet:"7"`
+	// FrontSuspension front suspension
+	FrontSuspension uint8 `json:"front_suspension" packet:"8"`
+	// RearSuspension rear suspension
+	RearSuspension uint8 `json:"rear_suspension" packet:"9"`
+	// FrontAntiRollBar front anti-roll bar
+	FrontAntiRollBar uint8 `json:"front_anti_roll_bar" packet:"10"`
+	// RearAntiRollBar rear anti-roll bar
+	RearAntiRollBar uint8 `json:"rear_anti_roll_bar" packet:"11"`
+	// FrontSuspensionHeight front ride height
+	FrontSuspensionHeight uint8 `json:"front_suspension_height" packet:"12"`
+	// RearSuspensionHeight rear ride height
+	RearSuspensionHeight uint8 `json:"rear_suspension_height" packet:"13"`
+	// BrakePressure brake pressure (percentage)
+	BrakePressure uint8 `json:"brake_pressure" packet:"14"`
+	// BrakeBias brake bias (percentage)
+	BrakeBias uint8 `json:"brake_bias" packet:"15"`
+	// RearLeftTyrePressure rear left tyre pressure in PSI
+	RearLeftTyrePressure float32 `json:"rear_left_tyre_pressure" packet:"16"`
+	// RearRightTyrePressure rear right tyre pressure in PSI
+	RearRightTyrePressure float32 `json:"rear_right_tyre_pressure" packet:"17"`
+	// FrontLeftTyrePressure front left tyre pressure in PSI
+	FrontLeftTyrePressure float32 `json:"front_left_tyre_pressure" packet:"18"`
+	// FrontRightTyrePressure front right tyre pressure in PSI
+	FrontRightTyrePressure float32 `json:"front_right_tyre_pressure" packet:"19"`
+	// Ballast ballast
+	Ballast uint8 `json:"ballast" packet:"20"`
+	// FuelLoad fuel load in kg
+	FuelLoad float32 `json:"fuel_load" packet:"21"`
+}
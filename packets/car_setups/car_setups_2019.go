@@ -0,0 +1,57 @@
+package car_setups
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet2019 F1 2019 car setups data
+type Packet2019 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// CarSetups setups for all cars on track
+	CarSetups [20]CarSetupData2019 `json:"car_setups" packet:"0"`
+}
+
+// CarSetupData2019 F1 2019 per-car setup data
+type CarSetupData2019 struct {
+	// FrontWing front wing aero
+	FrontWing uint8 `json:"front_wing" packet:"0"`
+	// RearWing rear wing aero
+	RearWing uint8 `json:"rear_wing" packet:"1"`
+	// OnThrottle differential adjustment on throttle (percentage)
+	OnThrottle uint8 `json:"on_throttle" packet:"2"`
+	// OffThrottle differential adjustment off throttle (percentage)
+	OffThrottle uint8 `json:"off_throttle" packet:"3"`
+	// FrontCamber front camber angle (suspension geometry)
+	FrontCamber float32 `json:"front_camber" packet:"4"`
+	// RearCamber rear camber angle (suspension geometry)
+	RearCamber float32 `json:"rear_camber" packet:"5"`
+	// FrontToe front toe angle (suspension geometry)
+	FrontToe float32 `json:"front_toe" packet:"6"`
+	// RearToe rear toe angle (suspension geometry)
+	RearToe float32 `json:"rear_toe" packet:"7"`
+	// FrontSuspension front suspension
+	FrontSuspension uint8 `json:"front_suspension" packet:"8"`
+	// RearSuspension rear suspension
+	RearSuspension uint8 `json:"rear_suspension" packet:"9"`
+	// FrontAntiRollBar front anti-roll bar
+	FrontAntiRollBar uint8 `json:"front_anti_roll_bar" packet:"10"`
+	// RearAntiRollBar rear anti-roll bar
+	RearAntiRollBar uint8 `json:"rear_anti_roll_bar" packet:"11"`
+	// FrontSuspensionHeight front ride height
+	FrontSuspensionHeight uint8 `json:"front_suspension_height" packet:"12"`
+	// RearSuspensionHeight rear ride height
+	RearSuspensionHeight uint8 `json:"rear_suspension_height" packet:"13"`
+	// BrakePressure brake pressure (percentage)
+	BrakePressure uint8 `json:"brake_pressure" packet:"14"`
+	// BrakeBias brake bias (percentage)
+	BrakeBias uint8 `json:"brake_bias" packet:"15"`
+	// FrontTyrePressure front tyre pressure in PSI
+	FrontTyrePressure float32 `json:"front_tyre_pressure" packet:"16"`
+	// RearTyrePressure rear tyre pressure in PSI
+	RearTyrePressure float32 `json:"rear_tyre_pressure" packet:"17"`
+	// Ballast ballast
+	Ballast uint8 `json:"ballast" packet:"18"`
+	// FuelLoad fuel load in kg
+	FuelLoad float32 `json:"fuel_load" packet:"19"`
+}
@@ -0,0 +1,98 @@
+package car_setups_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/car_setups"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"testing"
+)
+
+// setupStart append the fields up to BrakeBias of car i, which every format has
+func setupStart(b *packettest.Builder, i int) {
+	b.U8(uint8(i + 1)).U8(uint8(i + 2)).U8(uint8(50 + i)).U8(uint8(60 + i))
+	b.F32(-3.5 + float32(i)).F32(-2 + float32(i)).F32(0.25 + float32(i)).F32(0.5 + float32(i))
+	b.U8(uint8(i + 3)).U8(uint8(i + 4)).U8(uint8(i + 5)).U8(uint8(i + 6)).U8(uint8(i + 7)).U8(uint8(i + 8))
+	b.U8(uint8(90 + i)).U8(uint8(50 + i))
+}
+
+func TestGolden2019(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2019, common.PacketIDCarSetups)
+	b := &packettest.Builder{}
+	for i := 0; i < 20; i++ {
+		setupStart(b, i)
+		b.F32(23.5 + float32(i)).F32(21.5 + float32(i))
+		b.U8(uint8(i + 9)).F32(100 - float32(i))
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*car_setups.Packet2019)
+	for i, setup := range p.CarSetups {
+		want := car_setups.CarSetupData2019{
+			FrontWing:             uint8(i + 1),
+			RearWing:              uint8(i + 2),
+			OnThrottle:            uint8(50 + i),
+			OffThrottle:           uint8(60 + i),
+			FrontCamber:           -3.5 + float32(i),
+			RearCamber:            -2 + float32(i),
+			FrontToe:              0.25 + float32(i),
+			RearToe:               0.5 + float32(i),
+			FrontSuspension:       uint8(i + 3),
+			RearSuspension:        uint8(i + 4),
+			FrontAntiRollBar:      uint8(i + 5),
+			RearAntiRollBar:       uint8(i + 6),
+			FrontSuspensionHeight: uint8(i + 7),
+			RearSuspensionHeight:  uint8(i + 8),
+			BrakePressure:         uint8(90 + i),
+			BrakeBias:             uint8(50 + i),
+			FrontTyrePressure:     23.5 + float32(i),
+			RearTyrePressure:      21.5 + float32(i),
+			Ballast:               uint8(i + 9),
+			FuelLoad:              100 - float32(i),
+		}
+		if setup != want {
+			t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, setup, want)
+		}
+	}
+}
+
+func TestGolden(t *testing.T) {
+	for _, format := range []uint16{common.PacketFormat2020, common.PacketFormat2021, common.PacketFormat2022} {
+		layout := packettest.LayoutOf(format, common.PacketIDCarSetups)
+		b := &packettest.Builder{}
+		for i := 0; i < 22; i++ {
+			setupStart(b, i)
+			b.F32(21.5 + float32(i)).F32(21.75 + float32(i)).F32(23.5 + float32(i)).F32(23.75 + float32(i))
+			b.U8(uint8(i + 9)).F32(100 - float32(i))
+		}
+
+		p := packettest.Golden(t, layout, b.Bytes()).(*car_setups.Packet)
+		for i, setup := range p.CarSetups {
+			want := car_setups.CarSetupData{
+				FrontWing:              uint8(i + 1),
+				RearWing:               uint8(i + 2),
+				OnThrottle:             uint8(50 + i),
+				OffThrottle:            uint8(60 + i),
+				FrontCamber:            -3.5 + float32(i),
+				RearCamber:             -2 + float32(i),
+				FrontToe:               0.25 + float32(i),
+				RearToe:                0.5 + float32(i),
+				FrontSuspension:        uint8(i + 3),
+				RearSuspension:         uint8(i + 4),
+				FrontAntiRollBar:       uint8(i + 5),
+				RearAntiRollBar:        uint8(i + 6),
+				FrontSuspensionHeight:  uint8(i + 7),
+				RearSuspensionHeight:   uint8(i + 8),
+				BrakePressure:          uint8(90 + i),
+				BrakeBias:              uint8(50 + i),
+				RearLeftTyrePressure:   21.5 + float32(i),
+				RearRightTyrePressure:  21.75 + float32(i),
+				FrontLeftTyrePressure:  23.5 + float32(i),
+				FrontRightTyrePressure: 23.75 + float32(i),
+				Ballast:                uint8(i + 9),
+				FuelLoad:               100 - float32(i),
+			}
+			if setup != want {
+				t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, setup, want)
+			}
+		}
+	}
+}
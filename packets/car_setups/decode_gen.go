@@ -0,0 +1,145 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package car_setups
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// DecodeFrom decode CarSetupData from its wire format, returning the number of bytes read
+func (p *CarSetupData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 49 {
+		return 0, fmt.Errorf("unable to decode CarSetupData: need 49 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 49, nil
+}
+
+// PacketSize size of CarSetupData on the wire in bytes
+func (p *CarSetupData) PacketSize() int {
+	return 49
+}
+
+// decode decode CarSetupData from data holding at least 49 bytes
+func (p *CarSetupData) decode(data []byte) error {
+	p.FrontWing = uint8(data[0])
+	p.RearWing = uint8(data[1])
+	p.OnThrottle = uint8(data[2])
+	p.OffThrottle = uint8(data[3])
+	p.FrontCamber = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[4:])))
+	p.RearCamber = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[8:])))
+	p.FrontToe = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[12:])))
+	p.RearToe = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[16:])))
+	p.FrontSuspension = uint8(data[20])
+	p.RearSuspension = uint8(data[21])
+	p.FrontAntiRollBar = uint8(data[22])
+	p.RearAntiRollBar = uint8(data[23])
+	p.FrontSuspensionHeight = uint8(data[24])
+	p.RearSuspensionHeight = uint8(data[25])
+	p.BrakePressure = uint8(data[26])
+	p.BrakeBias = uint8(data[27])
+	p.RearLeftTyrePressure = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[28:])))
+	p.RearRightTyrePressure = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[32:])))
+	p.FrontLeftTyrePressure = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[36:])))
+	p.FrontRightTyrePressure = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[40:])))
+	p.Ballast = uint8(data[44])
+	p.FuelLoad = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[45:])))
+	return nil
+}
+
+// DecodeFrom decode CarSetupData2019 from its wire format, returning the number of bytes read
+func (p *CarSetupData2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 41 {
+		return 0, fmt.Errorf("unable to decode CarSetupData2019: need 41 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 41, nil
+}
+
+// PacketSize size of CarSetupData2019 on the wire in bytes
+func (p *CarSetupData2019) PacketSize() int {
+	return 41
+}
+
+// decode decode CarSetupData2019 from data holding at least 41 bytes
+func (p *CarSetupData2019) decode(data []byte) error {
+	p.FrontWing = uint8(data[0])
+	p.RearWing = uint8(data[1])
+	p.OnThrottle = uint8(data[2])
+	p.OffThrottle = uint8(data[3])
+	p.FrontCamber = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[4:])))
+	p.RearCamber = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[8:])))
+	p.FrontToe = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[12:])))
+	p.RearToe = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[16:])))
+	p.FrontSuspension = uint8(data[20])
+	p.RearSuspension = uint8(data[21])
+	p.FrontAntiRollBar = uint8(data[22])
+	p.RearAntiRollBar = uint8(data[23])
+	p.FrontSuspensionHeight = uint8(data[24])
+	p.RearSuspensionHeight = uint8(data[25])
+	p.BrakePressure = uint8(data[26])
+	p.BrakeBias = uint8(data[27])
+	p.FrontTyrePressure = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[28:])))
+	p.RearTyrePressure = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[32:])))
+	p.Ballast = uint8(data[36])
+	p.FuelLoad = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[37:])))
+	return nil
+}
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1078 {
+		return 0, fmt.Errorf("unable to decode Packet: need 1078 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1078, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 1078
+}
+
+// decode decode Packet from data holding at least 1078 bytes
+func (p *Packet) decode(data []byte) error {
+	for i0 := range p.CarSetups {
+		if err := p.CarSetups[i0].decode(data[0+i0*49:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarSetups item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2019 from its wire format, returning the number of bytes read
+func (p *Packet2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 820 {
+		return 0, fmt.Errorf("unable to decode Packet2019: need 820 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 820, nil
+}
+
+// PacketSize size of Packet2019 on the wire in bytes
+func (p *Packet2019) PacketSize() int {
+	return 820
+}
+
+// decode decode Packet2019 from data holding at least 820 bytes
+func (p *Packet2019) decode(data []byte) error {
+	for i0 := range p.CarSetups {
+		if err := p.CarSetups[i0].decode(data[0+i0*41:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarSetups item: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package car_status
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+)
+
+// Packet2019 F1 2019 car status data
+type Packet2019 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// CarStatus status for all cars on track
+	CarStatus [20]CarStatusData2019 `json:"car_status" packet:"0"`
+}
+
+// CarStatusData2019 F1 2019 per-car status data
+type CarStatusData2019 struct {
+	// TractionControl traction control level
+	TractionControl TractionControl `json:"traction_control" packet:"0"`
+	// AntiLockBrakes whether ABS is on
+	AntiLockBrakes bool `json:"anti_lock_brakes" packet:"1"`
+	// FuelMix fuel mix setting
+	FuelMix FuelMix `json:"fuel_mix" packet:"2"`
+	// FrontBrakeBias front brake bias percentage
+	FrontBrakeBias uint8 `json:"front_brake_bias" packet:"3"`
+	// PitLimiterStatus whether the pit limiter is on
+	PitLimiterStatus bool `json:"pit_limiter_status" packet:"4"`
+	// FuelInTank current fuel mass in kg
+	FuelInTank float32 `json:"fuel_in_tank" packet:"5"`
+	// FuelCapacity fuel capacity in kg
+	FuelCapacity float32 `json:"fuel_capacity" packet:"6"`
+	// FuelRemainingLaps fuel remaining in terms of laps (value on MFD)
+	FuelRemainingLaps float32 `json:"fuel_remaining_laps" packet:"7"`
+	// MaxRPM RPM at which the car hits the rev limiter
+	MaxRPM uint16 `json:"max_rpm" packet:"8"`
+	// IdleRPM idle RPM of the car
+	IdleRPM uint16 `json:"idle_rpm" packet:"9"`
+	// MaxGears number of gears the car has
+	MaxGears uint8 `json:"max_gears" packet:"10"`
+	// DRSAllowed whether DRS is allowed to be used, 0 not allowed, 1 allowed, -1 unknown
+	DRSAllowed int8 `json:"drs_allowed" packet:"11"`
+	// TyresWear tyre wear percentage
+	TyresWear WheelDataUInt8 `json:"tyres_wear" packet:"12"`
+	// ActualTyreCompound compound of the fitted tyres as used by the physics
+	ActualTyreCompound ActualTyreCompound `json:"actual_tyre_compound" packet:"13"`
+	// VisualTyreCompound compound of the fitted tyres as shown to the player
+	VisualTyreCompound VisualTyreCompound `json:"visual_tyre_compound" packet:"14"`
+	// TyresDamage tyre damage percentage
+	TyresDamage WheelDataUInt8 `json:"tyres_damage" packet:"15"`
+	// FrontLeftWingDamage front left wing damage percentage
+	FrontLeftWingDamage uint8 `json:"front_left_wing_damage" packet:"16"`
+	// FrontRightWingDamage front right wing damage percentage
+	FrontRightWingDamage uint8 `json:"front_right_wing_damage" packet:"17"`
+	// RearWingDamage rear wing damage percentage
+	RearWingDamage uint8 `json:"rear_wing_damage" packet:"18"`
+	// EngineDamage engine damage percentage
+	EngineDamage uint8 `json:"engine_damage" packet:"19"`
+	// GearBoxDamage gear box damage percentage
+	GearBoxDamage uint8 `json:"gear_box_damage" packet:"20"`
+	// VehicleFIAFlags flag being shown to the car
+	VehicleFIAFlags session.ZoneFlag `json:"vehicle_fia_flags" packet:"21"`
+	// ERSStoreEnergy ERS energy store in Joules
+	ERSStoreEnergy float32 `json:"ers_store_energy" packet:"22"`
+	// ERSDeployMode ERS deployment mode
+	ERSDeployMode ERSDeployMode `json:"ers_deploy_mode" packet:"23"`
+	// ERSHarvestedThisLapMGUK ERS energy harvested this lap by the MGU-K
+	ERSHarvestedThisLapMGUK float32 `json:"ers_harvested_this_lap_mguk" packet:"24"`
+	// ERSHarvestedThisLapMGUH ERS energy harvested this lap by the MGU-H
+	ERSHarvestedThisLapMGUH float32 `json:"ers_harvested_this_lap_mguh" packet:"25"`
+	// ERSDeployedThisLap ERS energy deployed this lap
+	ERSDeployedThisLap float32 `json:"ers_deployed_this_lap" packet:"26"`
+}
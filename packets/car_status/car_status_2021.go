@@ -0,0 +1,64 @@
+package car_status
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+)
+
+// Packet2021 F1 2021 car status data, also used by F1 2022
+type Packet2021 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// CarStatus status for all cars on track
+	CarStatus [22]CarStatusData2021 `json:"car_status" packet:"0"`
+}
+
+// CarStatusData2021 F1 2021 per-car status data, damage moved to the car damage packet
+type CarStatusData2021 struct {
+	// TractionControl traction control level
+	TractionControl TractionControl `json:"traction_control" packet:"0"`
+	// AntiLockBrakes whether ABS is on
+	AntiLockBrakes bool `json:"anti_lock_brakes" packet:"1"`
+	// FuelMix fuel mix setting
+	FuelMix FuelMix `json:"fuel_mix" packet:"2"`
+	// FrontBrakeBias front brake bias percentage
+	FrontBrakeBias uint8 `json:"front_brake_bias" packet:"3"`
+	// PitLimiterStatus whether the pit limiter is on
+	PitLimiterStatus bool `json:"pit_limiter_status" packet:"4"`
+	// FuelInTank current fuel mass in kg
+	FuelInTank float32 `json:"fuel_in_tank" packet:"5"`
+	// FuelCapacity fuel capacity in kg
+	FuelCapacity float32 `json:"fuel_capacity" packet:"6"`
+	// FuelRemainingLaps fuel remaining in terms of laps (value on MFD)
+	FuelRemainingLaps float32 `json:"fuel_remaining_laps" packet:"7"`
+	// MaxRPM RPM at which the car hits the rev limiter
+	MaxRPM uint16 `json:"max_rpm" packet:"8"`
+	// IdleRPM idle RPM of the car
+	IdleRPM uint16 `json:"idle_rpm" packet:"9"`
+	// MaxGears number of gears the car has
+	MaxGears uint8 `json:"max_gears" packet:"10"`
+	// DRSAllowed whether DRS is allowed to be used
+	DRSAllowed bool `json:"drs_allowed" packet:"11"`
+	// DRSActivationDistance distance in metres until DRS can be used, 0 if not available
+	DRSActivationDistance uint16 `json:"drs_activation_distance" packet:"12"`
+	// ActualTyreCompound compound of the fitted tyres as used by the physics
+	ActualTyreCompound ActualTyreCompound `json:"actual_tyre_compound" packet:"13"`
+	// VisualTyreCompound compound of the fitted tyres as shown to the player
+	VisualTyreCompound VisualTyreCompound `json:"visual_tyre_compound" packet:"14"`
+	// TyresAgeLaps age in laps of the current set of tyres
+	TyresAgeLaps uint8 `json:"tyres_age_laps" packet:"15"`
+	// VehicleFIAFlags flag being shown to the car
+	VehicleFIAFlags session.ZoneFlag `json:"vehicle_fia_flags" packet:"16"`
+	// ERSStoreEnergy ERS energy store in Joules
+	ERSStoreEnergy float32 `json:"ers_store_energy" packet:"17"`
+	// ERSDeployMode ERS deployment mode
+	ERSDeployMode ERSDeployMode `json:"ers_deploy_mode" packet:"18"`
+	// ERSHarvestedThisLapMGUK ERS energy harvested this lap by the MGU-K
+	ERSHarvestedThisLapMGUK float32 `json:"ers_harvested_this_lap_mguk" packet:"19"`
+	// ERSHarvestedThisLapMGUH ERS energy harvested this lap by the MGU-H
+	ERSHarvestedThisLapMGUH float32 `json:"ers_harvested_this_lap_mguh" packet:"20"`
+	// ERSDeployedThisLap ERS energy deployed this lap
+	ERSDeployedThisLap float32 `json:"ers_deployed_this_lap" packet:"21"`
+	// NetworkPaused whether the car is paused in a network game
+	NetworkPaused bool `json:"network_paused" packet:"22"`
+}
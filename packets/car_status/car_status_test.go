@@ -0,0 +1,173 @@
+package car_status_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/car_status"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+	"testing"
+)
+
+// fuel append the fields up to MaxGears of car i, which every format has
+func fuel(b *packettest.Builder, i int) {
+	b.U8(uint8(car_status.TractionControlHigh)).Bool(true).U8(uint8(car_status.FuelMixRich)).U8(uint8(50 + i)).Bool(i == 3)
+	b.F32(50.5 - float32(i)).F32(110).F32(float32(i) + 0.25)
+	b.U16(uint16(13000 + i)).U16(uint16(4000 + i)).U8(8)
+}
+
+// ers append the ERS fields of car i, which every format has after VehicleFIAFlags
+func ers(b *packettest.Builder, i int) {
+	b.F32(4000000 - float32(i)).U8(uint8(car_status.ERSDeployModeOvertake)).F32(1000.5).F32(2000.5).F32(float32(3000 + i))
+}
+
+func TestGolden2019(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2019, common.PacketIDCarStatus)
+	b := &packettest.Builder{}
+	for i := 0; i < 20; i++ {
+		fuel(b, i)
+		b.I8(-1)
+		b.U8(1).U8(2).U8(3).U8(uint8(4 + i))
+		b.U8(uint8(car_status.ActualTyreCompoundC3)).U8(uint8(car_status.VisualTyreCompoundSoft))
+		b.U8(5).U8(6).U8(7).U8(uint8(8 + i))
+		b.U8(10).U8(20).U8(30).U8(40).U8(uint8(i))
+		b.I8(int8(session.ZoneFlagYellow))
+		ers(b, i)
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*car_status.Packet2019)
+	for i, status := range p.CarStatus {
+		want := car_status.CarStatusData2019{
+			TractionControl:         car_status.TractionControlHigh,
+			AntiLockBrakes:          true,
+			FuelMix:                 car_status.FuelMixRich,
+			FrontBrakeBias:          uint8(50 + i),
+			PitLimiterStatus:        i == 3,
+			FuelInTank:              50.5 - float32(i),
+			FuelCapacity:            110,
+			FuelRemainingLaps:       float32(i) + 0.25,
+			MaxRPM:                  uint16(13000 + i),
+			IdleRPM:                 uint16(4000 + i),
+			MaxGears:                8,
+			DRSAllowed:              -1,
+			TyresWear:               car_status.WheelDataUInt8{RearLeft: 1, RearRight: 2, FrontLeft: 3, FrontRight: uint8(4 + i)},
+			ActualTyreCompound:      car_status.ActualTyreCompoundC3,
+			VisualTyreCompound:      car_status.VisualTyreCompoundSoft,
+			TyresDamage:             car_status.WheelDataUInt8{RearLeft: 5, RearRight: 6, FrontLeft: 7, FrontRight: uint8(8 + i)},
+			FrontLeftWingDamage:     10,
+			FrontRightWingDamage:    20,
+			RearWingDamage:          30,
+			EngineDamage:            40,
+			GearBoxDamage:           uint8(i),
+			VehicleFIAFlags:         session.ZoneFlagYellow,
+			ERSStoreEnergy:          4000000 - float32(i),
+			ERSDeployMode:           car_status.ERSDeployModeOvertake,
+			ERSHarvestedThisLapMGUK: 1000.5,
+			ERSHarvestedThisLapMGUH: 2000.5,
+			ERSDeployedThisLap:      float32(3000 + i),
+		}
+		if status != want {
+			t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, status, want)
+		}
+	}
+}
+
+func TestGolden2020(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2020, common.PacketIDCarStatus)
+	b := &packettest.Builder{}
+	for i := 0; i < 22; i++ {
+		fuel(b, i)
+		b.Bool(true).U16(uint16(i * 10))
+		b.U8(1).U8(2).U8(3).U8(uint8(4 + i))
+		b.U8(uint8(car_status.ActualTyreCompoundC3)).U8(uint8(car_status.VisualTyreCompoundSoft)).U8(uint8(i))
+		b.U8(5).U8(6).U8(7).U8(uint8(8 + i))
+		b.U8(10).U8(20).U8(30).Bool(i == 7).U8(40).U8(uint8(i))
+		b.I8(int8(session.ZoneFlagBlue))
+		ers(b, i)
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*car_status.Packet)
+	for i, status := range p.CarStatus {
+		want := car_status.CarStatusData{
+			TractionControl:         car_status.TractionControlHigh,
+			AntiLockBrakes:          true,
+			FuelMix:                 car_status.FuelMixRich,
+			FrontBrakeBias:          uint8(50 + i),
+			PitLimiterStatus:        i == 3,
+			FuelInTank:              50.5 - float32(i),
+			FuelCapacity:            110,
+			FuelRemainingLaps:       float32(i) + 0.25,
+			MaxRPM:                  uint16(13000 + i),
+			IdleRPM:                 uint16(4000 + i),
+			MaxGears:                8,
+			DRSAllowed:              true,
+			DRSActivationDistance:   uint16(i * 10),
+			TyresWear:               car_status.WheelDataUInt8{RearLeft: 1, RearRight: 2, FrontLeft: 3, FrontRight: uint8(4 + i)},
+			ActualTyreCompound:      car_status.ActualTyreCompoundC3,
+			VisualTyreCompound:      car_status.VisualTyreCompoundSoft,
+			TyresAgeLaps:            uint8(i),
+			TyresDamage:             car_status.WheelDataUInt8{RearLeft: 5, RearRight: 6, FrontLeft: 7, FrontRight: uint8(8 + i)},
+			FrontLeftWingDamage:     10,
+			FrontRightWingDamage:    20,
+			RearWingDamage:          30,
+			DRSFault:                i == 7,
+			EngineDamage:            40,
+			GearBoxDamage:           uint8(i),
+			VehicleFIAFlags:         session.ZoneFlagBlue,
+			ERSStoreEnergy:          4000000 - float32(i),
+			ERSDeployMode:           car_status.ERSDeployModeOvertake,
+			ERSHarvestedThisLapMGUK: 1000.5,
+			ERSHarvestedThisLapMGUH: 2000.5,
+			ERSDeployedThisLap:      float32(3000 + i),
+		}
+		if status != want {
+			t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, status, want)
+		}
+	}
+}
+
+func TestGolden2021(t *testing.T) {
+	for _, format := range []uint16{common.PacketFormat2021, common.PacketFormat2022} {
+		layout := packettest.LayoutOf(format, common.PacketIDCarStatus)
+		b := &packettest.Builder{}
+		for i := 0; i < 22; i++ {
+			fuel(b, i)
+			b.Bool(false).U16(uint16(i * 10))
+			b.U8(uint8(car_status.ActualTyreCompoundC3)).U8(uint8(car_status.VisualTyreCompoundSoft)).U8(uint8(i))
+			b.I8(int8(session.ZoneFlagGreen))
+			ers(b, i)
+			b.Bool(i == 21)
+		}
+
+		p := packettest.Golden(t, layout, b.Bytes()).(*car_status.Packet2021)
+		for i, status := range p.CarStatus {
+			want := car_status.CarStatusData2021{
+				TractionControl:         car_status.TractionControlHigh,
+				AntiLockBrakes:          true,
+				FuelMix:                 car_status.FuelMixRich,
+				FrontBrakeBias:          uint8(50 + i),
+				PitLimiterStatus:        i == 3,
+				FuelInTank:              50.5 - float32(i),
+				FuelCapacity:            110,
+				FuelRemainingLaps:       float32(i) + 0.25,
+				MaxRPM:                  uint16(13000 + i),
+				IdleRPM:                 uint16(4000 + i),
+				MaxGears:                8,
+				DRSAllowed:              false,
+				DRSActivationDistance:   uint16(i * 10),
+				ActualTyreCompound:      car_status.ActualTyreCompoundC3,
+				VisualTyreCompound:      car_status.VisualTyreCompoundSoft,
+				TyresAgeLaps:            uint8(i),
+				VehicleFIAFlags:         session.ZoneFlagGreen,
+				ERSStoreEnergy:          4000000 - float32(i),
+				ERSDeployMode:           car_status.ERSDeployModeOvertake,
+				ERSHarvestedThisLapMGUK: 1000.5,
+				ERSHarvestedThisLapMGUH: 2000.5,
+				ERSDeployedThisLap:      float32(3000 + i),
+				NetworkPaused:           i == 21,
+			}
+			if status != want {
+				t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, status, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,294 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package car_status
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+	"math"
+)
+
+// DecodeFrom decode CarStatusData from its wire format, returning the number of bytes read
+func (p *CarStatusData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 60 {
+		return 0, fmt.Errorf("unable to decode CarStatusData: need 60 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 60, nil
+}
+
+// PacketSize size of CarStatusData on the wire in bytes
+func (p *CarStatusData) PacketSize() int {
+	return 60
+}
+
+// decode decode CarStatusData from data holding at least 60 bytes
+func (p *CarStatusData) decode(data []byte) error {
+	p.TractionControl = TractionControl(data[0])
+	if data[1] > 1 {
+		return fmt.Errorf("failed to set AntiLockBrakes value: unexpected byte value %v is <0 or >1", data[1])
+	}
+	p.AntiLockBrakes = bool(data[1] == 1)
+	p.FuelMix = FuelMix(data[2])
+	p.FrontBrakeBias = uint8(data[3])
+	if data[4] > 1 {
+		return fmt.Errorf("failed to set PitLimiterStatus value: unexpected byte value %v is <0 or >1", data[4])
+	}
+	p.PitLimiterStatus = bool(data[4] == 1)
+	p.FuelInTank = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[5:])))
+	p.FuelCapacity = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[9:])))
+	p.FuelRemainingLaps = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[13:])))
+	p.MaxRPM = uint16(binary.LittleEndian.Uint16(data[17:]))
+	p.IdleRPM = uint16(binary.LittleEndian.Uint16(data[19:]))
+	p.MaxGears = uint8(data[21])
+	if data[22] > 1 {
+		return fmt.Errorf("failed to set DRSAllowed value: unexpected byte value %v is <0 or >1", data[22])
+	}
+	p.DRSAllowed = bool(data[22] == 1)
+	p.DRSActivationDistance = uint16(binary.LittleEndian.Uint16(data[23:]))
+	if err := p.TyresWear.decode(data[25:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresWear: %v", err)
+	}
+	p.ActualTyreCompound = ActualTyreCompound(data[29])
+	p.VisualTyreCompound = VisualTyreCompound(data[30])
+	p.TyresAgeLaps = uint8(data[31])
+	if err := p.TyresDamage.decode(data[32:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresDamage: %v", err)
+	}
+	p.FrontLeftWingDamage = uint8(data[36])
+	p.FrontRightWingDamage = uint8(data[37])
+	p.RearWingDamage = uint8(data[38])
+	if data[39] > 1 {
+		return fmt.Errorf("failed to set DRSFault value: unexpected byte value %v is <0 or >1", data[39])
+	}
+	p.DRSFault = bool(data[39] == 1)
+	p.EngineDamage = uint8(data[40])
+	p.GearBoxDamage = uint8(data[41])
+	p.VehicleFIAFlags = session.ZoneFlag(data[42])
+	p.ERSStoreEnergy = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[43:])))
+	p.ERSDeployMode = ERSDeployMode(data[47])
+	p.ERSHarvestedThisLapMGUK = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[48:])))
+	p.ERSHarvestedThisLapMGUH = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[52:])))
+	p.ERSDeployedThisLap = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[56:])))
+	return nil
+}
+
+// DecodeFrom decode CarStatusData2019 from its wire format, returning the number of bytes read
+func (p *CarStatusData2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 56 {
+		return 0, fmt.Errorf("unable to decode CarStatusData2019: need 56 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 56, nil
+}
+
+// PacketSize size of CarStatusData2019 on the wire in bytes
+func (p *CarStatusData2019) PacketSize() int {
+	return 56
+}
+
+// decode decode CarStatusData2019 from data holding at least 56 bytes
+func (p *CarStatusData2019) decode(data []byte) error {
+	p.TractionControl = TractionControl(data[0])
+	if data[1] > 1 {
+		return fmt.Errorf("failed to set AntiLockBrakes value: unexpected byte value %v is <0 or >1", data[1])
+	}
+	p.AntiLockBrakes = bool(data[1] == 1)
+	p.FuelMix = FuelMix(data[2])
+	p.FrontBrakeBias = uint8(data[3])
+	if data[4] > 1 {
+		return fmt.Errorf("failed to set PitLimiterStatus value: unexpected byte value %v is <0 or >1", data[4])
+	}
+	p.PitLimiterStatus = bool(data[4] == 1)
+	p.FuelInTank = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[5:])))
+	p.FuelCapacity = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[9:])))
+	p.FuelRemainingLaps = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[13:])))
+	p.MaxRPM = uint16(binary.LittleEndian.Uint16(data[17:]))
+	p.IdleRPM = uint16(binary.LittleEndian.Uint16(data[19:]))
+	p.MaxGears = uint8(data[21])
+	p.DRSAllowed = int8(data[22])
+	if err := p.TyresWear.decode(data[23:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresWear: %v", err)
+	}
+	p.ActualTyreCompound = ActualTyreCompound(data[27])
+	p.VisualTyreCompound = VisualTyreCompound(data[28])
+	if err := p.TyresDamage.decode(data[29:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresDamage: %v", err)
+	}
+	p.FrontLeftWingDamage = uint8(data[33])
+	p.FrontRightWingDamage = uint8(data[34])
+	p.RearWingDamage = uint8(data[35])
+	p.EngineDamage = uint8(data[36])
+	p.GearBoxDamage = uint8(data[37])
+	p.VehicleFIAFlags = session.ZoneFlag(data[38])
+	p.ERSStoreEnergy = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[39:])))
+	p.ERSDeployMode = ERSDeployMode(data[43])
+	p.ERSHarvestedThisLapMGUK = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[44:])))
+	p.ERSHarvestedThisLapMGUH = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[48:])))
+	p.ERSDeployedThisLap = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[52:])))
+	return nil
+}
+
+// DecodeFrom decode CarStatusData2021 from its wire format, returning the number of bytes read
+func (p *CarStatusData2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 47 {
+		return 0, fmt.Errorf("unable to decode CarStatusData2021: need 47 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 47, nil
+}
+
+// PacketSize size of CarStatusData2021 on the wire in bytes
+func (p *CarStatusData2021) PacketSize() int {
+	return 47
+}
+
+// decode decode CarStatusData2021 from data holding at least 47 bytes
+func (p *CarStatusData2021) decode(data []byte) error {
+	p.TractionControl = TractionControl(data[0])
+	if data[1] > 1 {
+		return fmt.Errorf("failed to set AntiLockBrakes value: unexpected byte value %v is <0 or >1", data[1])
+	}
+	p.AntiLockBrakes = bool(data[1] == 1)
+	p.FuelMix = FuelMix(data[2])
+	p.FrontBrakeBias = uint8(data[3])
+	if data[4] > 1 {
+		return fmt.Errorf("failed to set PitLimiterStatus value: unexpected byte value %v is <0 or >1", data[4])
+	}
+	p.PitLimiterStatus = bool(data[4] == 1)
+	p.FuelInTank = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[5:])))
+	p.FuelCapacity = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[9:])))
+	p.FuelRemainingLaps = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[13:])))
+	p.MaxRPM = uint16(binary.LittleEndian.Uint16(data[17:]))
+	p.IdleRPM = uint16(binary.LittleEndian.Uint16(data[19:]))
+	p.MaxGears = uint8(data[21])
+	if data[22] > 1 {
+		return fmt.Errorf("failed to set DRSAllowed value: unexpected byte value %v is <0 or >1", data[22])
+	}
+	p.DRSAllowed = bool(data[22] == 1)
+	p.DRSActivationDistance = uint16(binary.LittleEndian.Uint16(data[23:]))
+	p.ActualTyreCompound = ActualTyreCompound(data[25])
+	p.VisualTyreCompound = VisualTyreCompound(data[26])
+	p.TyresAgeLaps = uint8(data[27])
+	p.VehicleFIAFlags = session.ZoneFlag(data[28])
+	p.ERSStoreEnergy = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[29:])))
+	p.ERSDeployMode = ERSDeployMode(data[33])
+	p.ERSHarvestedThisLapMGUK = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[34:])))
+	p.ERSHarvestedThisLapMGUH = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[38:])))
+	p.ERSDeployedThisLap = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[42:])))
+	if data[46] > 1 {
+		return fmt.Errorf("failed to set NetworkPaused value: unexpected byte value %v is <0 or >1", data[46])
+	}
+	p.NetworkPaused = bool(data[46] == 1)
+	return nil
+}
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1320 {
+		return 0, fmt.Errorf("unable to decode Packet: need 1320 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1320, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 1320
+}
+
+// decode decode Packet from data holding at least 1320 bytes
+func (p *Packet) decode(data []byte) error {
+	for i0 := range p.CarStatus {
+		if err := p.CarStatus[i0].decode(data[0+i0*60:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarStatus item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2019 from its wire format, returning the number of bytes read
+func (p *Packet2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1120 {
+		return 0, fmt.Errorf("unable to decode Packet2019: need 1120 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1120, nil
+}
+
+// PacketSize size of Packet2019 on the wire in bytes
+func (p *Packet2019) PacketSize() int {
+	return 1120
+}
+
+// decode decode Packet2019 from data holding at least 1120 bytes
+func (p *Packet2019) decode(data []byte) error {
+	for i0 := range p.CarStatus {
+		if err := p.CarStatus[i0].decode(data[0+i0*56:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarStatus item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2021 from its wire format, returning the number of bytes read
+func (p *Packet2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1034 {
+		return 0, fmt.Errorf("unable to decode Packet2021: need 1034 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1034, nil
+}
+
+// PacketSize size of Packet2021 on the wire in bytes
+func (p *Packet2021) PacketSize() int {
+	return 1034
+}
+
+// decode decode Packet2021 from data holding at least 1034 bytes
+func (p *Packet2021) decode(data []byte) error {
+	for i0 := range p.CarStatus {
+		if err := p.CarStatus[i0].decode(data[0+i0*47:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarStatus item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode WheelDataUInt8 from its wire format, returning the number of bytes read
+func (p *WheelDataUInt8) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, fmt.Errorf("unable to decode WheelDataUInt8: need 4 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 4, nil
+}
+
+// PacketSize size of WheelDataUInt8 on the wire in bytes
+func (p *WheelDataUInt8) PacketSize() int {
+	return 4
+}
+
+// decode decode WheelDataUInt8 from data holding at least 4 bytes
+func (p *WheelDataUInt8) decode(data []byte) error {
+	p.RearLeft = uint8(data[0])
+	p.RearRight = uint8(data[1])
+	p.FrontLeft = uint8(data[2])
+	p.FrontRight = uint8(data[3])
+	return nil
+}
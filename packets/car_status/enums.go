@@ -0,0 +1,107 @@
+package car_status
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/enum-gen
+
+// TractionControl traction control assist level
+type TractionControl uint8
+
+const (
+	// TractionControlOff traction control off
+	TractionControlOff TractionControl = 0
+	// TractionControlMedium medium traction control
+	TractionControlMedium TractionControl = 1
+	// TractionControlHigh high traction control
+	TractionControlHigh TractionControl = 2
+)
+
+// FuelMix fuel mix setting
+type FuelMix uint8
+
+const (
+	// FuelMixLean lean
+	FuelMixLean FuelMix = 0
+	// FuelMixStandard standard
+	FuelMixStandard FuelMix = 1
+	// FuelMixRich rich
+	FuelMixRich FuelMix = 2
+	// FuelMixMax max
+	FuelMixMax FuelMix = 3
+)
+
+// ActualTyreCompound compound of the tyre fitted, as used by the game's physics
+type ActualTyreCompound uint8
+
+const (
+	// ActualTyreCompoundUnknown unknown compound
+	ActualTyreCompoundUnknown ActualTyreCompound = 0
+	// ActualTyreCompoundIntermediate F1 modern intermediate
+	ActualTyreCompoundIntermediate ActualTyreCompound = 7
+	// ActualTyreCompoundWet F1 modern wet
+	ActualTyreCompoundWet ActualTyreCompound = 8
+	// ActualTyreCompoundClassicDry F1 classic dry
+	ActualTyreCompoundClassicDry ActualTyreCompound = 9
+	// ActualTyreCompoundClassicWet F1 classic wet
+	ActualTyreCompoundClassicWet ActualTyreCompound = 10
+	// ActualTyreCompoundF2SuperSoft F2 super soft
+	ActualTyreCompoundF2SuperSoft ActualTyreCompound = 11
+	// ActualTyreCompoundF2Soft F2 soft
+	ActualTyreCompoundF2Soft ActualTyreCompound = 12
+	// ActualTyreCompoundF2Medium F2 medium
+	ActualTyreCompoundF2Medium ActualTyreCompound = 13
+	// ActualTyreCompoundF2Hard F2 hard
+	ActualTyreCompoundF2Hard ActualTyreCompound = 14
+	// ActualTyreCompoundF2Wet F2 wet
+	ActualTyreCompoundF2Wet ActualTyreCompound = 15
+	// ActualTyreCompoundC5 F1 modern C5
+	ActualTyreCompoundC5 ActualTyreCompound = 16
+	// ActualTyreCompoundC4 F1 modern C4
+	ActualTyreCompoundC4 ActualTyreCompound = 17
+	// ActualTyreCompoundC3 F1 modern C3
+	ActualTyreCompoundC3 ActualTyreCompound = 18
+	// ActualTyreCompoundC2 F1 modern C2
+	ActualTyreCompoundC2 ActualTyreCompound = 19
+	// ActualTyreCompoundC1 F1 modern C1
+	ActualTyreCompoundC1 ActualTyreCompound = 20
+)
+
+// VisualTyreCompound compound of the tyre fitted, as shown to the player
+type VisualTyreCompound uint8
+
+const (
+	// VisualTyreCompoundUnknown unknown compound
+	VisualTyreCompoundUnknown VisualTyreCompound = 0
+	// VisualTyreCompoundIntermediate intermediate
+	VisualTyreCompoundIntermediate VisualTyreCompound = 7
+	// VisualTyreCompoundWet wet
+	VisualTyreCompoundWet VisualTyreCompound = 8
+	// VisualTyreCompoundF2Wet F2 '19 wet
+	VisualTyreCompoundF2Wet VisualTyreCompound = 15
+	// VisualTyreCompoundSoft soft
+	VisualTyreCompoundSoft VisualTyreCompound = 16
+	// VisualTyreCompoundMedium medium
+	VisualTyreCompoundMedium VisualTyreCompound = 17
+	// VisualTyreCompoundHard hard
+	VisualTyreCompoundHard VisualTyreCompound = 18
+	// VisualTyreCompoundF2SuperSoft F2 '19 super soft
+	VisualTyreCompoundF2SuperSoft VisualTyreCompound = 19
+	// VisualTyreCompoundF2Soft F2 '19 soft
+	VisualTyreCompoundF2Soft VisualTyreCompound = 20
+	// VisualTyreCompoundF2Medium F2 '19 medium
+	VisualTyreCompoundF2Medium VisualTyreCompound = 21
+	// VisualTyreCompoundF2Hard F2 '19 hard
+	VisualTyreCompoundF2Hard VisualTyreCompound = 22
+)
+
+// ERSDeployMode ERS deployment mode
+type ERSDeployMode uint8
+
+const (
+	// ERSDeployModeNone none
+	ERSDeployModeNone ERSDeployMode = 0
+	// ERSDeployModeMedium medium
+	ERSDeployModeMedium ERSDeployMode = 1
+	// ERSDeployModeOvertake overtake
+	ERSDeployModeOvertake ERSDeployMode = 2
+	// ERSDeployModeHotlap hotlap
+	ERSDeployModeHotlap ERSDeployMode = 3
+)
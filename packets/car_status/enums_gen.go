@@ -0,0 +1,348 @@
+// Code generated by enum-gen. DO NOT EDIT.
+
+package car_status
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var tractionControlNames = map[TractionControl]string{
+	0: "off",
+	1: "medium",
+	2: "high",
+}
+
+var tractionControlValues = map[string]TractionControl{
+	"off":    0,
+	"medium": 1,
+	"high":   2,
+}
+
+// String name of the TractionControl, or the type and number for unknown values
+func (v TractionControl) String() string {
+	if name, ok := tractionControlNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("TractionControl(%d)", v)
+}
+
+// MarshalText name of the TractionControl, or the number for unknown values
+func (v TractionControl) MarshalText() ([]byte, error) {
+	if name, ok := tractionControlNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the TractionControl from its name or number
+func (v *TractionControl) UnmarshalText(text []byte) error {
+	if val, ok := tractionControlValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown TractionControl %q", text)
+	}
+	*v = TractionControl(n)
+	return nil
+}
+
+// MarshalJSON name of the TractionControl as a string, or its number for unknown values
+func (v TractionControl) MarshalJSON() ([]byte, error) {
+	if name, ok := tractionControlNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the TractionControl from its name as a string, or its number
+func (v *TractionControl) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var fuelMixNames = map[FuelMix]string{
+	0: "lean",
+	1: "standard",
+	2: "rich",
+	3: "max",
+}
+
+var fuelMixValues = map[string]FuelMix{
+	"lean":     0,
+	"standard": 1,
+	"rich":     2,
+	"max":      3,
+}
+
+// String name of the FuelMix, or the type and number for unknown values
+func (v FuelMix) String() string {
+	if name, ok := fuelMixNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("FuelMix(%d)", v)
+}
+
+// MarshalText name of the FuelMix, or the number for unknown values
+func (v FuelMix) MarshalText() ([]byte, error) {
+	if name, ok := fuelMixNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the FuelMix from its name or number
+func (v *FuelMix) UnmarshalText(text []byte) error {
+	if val, ok := fuelMixValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown FuelMix %q", text)
+	}
+	*v = FuelMix(n)
+	return nil
+}
+
+// MarshalJSON name of the FuelMix as a string, or its number for unknown values
+func (v FuelMix) MarshalJSON() ([]byte, error) {
+	if name, ok := fuelMixNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the FuelMix from its name as a string, or its number
+func (v *FuelMix) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var actualTyreCompoundNames = map[ActualTyreCompound]string{
+	0:  "unknown",
+	7:  "intermediate",
+	8:  "wet",
+	9:  "classic_dry",
+	10: "classic_wet",
+	11: "f2_super_soft",
+	12: "f2_soft",
+	13: "f2_medium",
+	14: "f2_hard",
+	15: "f2_wet",
+	16: "c5",
+	17: "c4",
+	18: "c3",
+	19: "c2",
+	20: "c1",
+}
+
+var actualTyreCompoundValues = map[string]ActualTyreCompound{
+	"unknown":       0,
+	"intermediate":  7,
+	"wet":           8,
+	"classic_dry":   9,
+	"classic_wet":   10,
+	"f2_super_soft": 11,
+	"f2_soft":       12,
+	"f2_medium":     13,
+	"f2_hard":       14,
+	"f2_wet":        15,
+	"c5":            16,
+	"c4":            17,
+	"c3":            18,
+	"c2":            19,
+	"c1":            20,
+}
+
+// String name of the ActualTyreCompound, or the type and number for unknown values
+func (v ActualTyreCompound) String() string {
+	if name, ok := actualTyreCompoundNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("ActualTyreCompound(%d)", v)
+}
+
+// MarshalText name of the ActualTyreCompound, or the number for unknown values
+func (v ActualTyreCompound) MarshalText() ([]byte, error) {
+	if name, ok := actualTyreCompoundNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the ActualTyreCompound from its name or number
+func (v *ActualTyreCompound) UnmarshalText(text []byte) error {
+	if val, ok := actualTyreCompoundValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown ActualTyreCompound %q", text)
+	}
+	*v = ActualTyreCompound(n)
+	return nil
+}
+
+// MarshalJSON name of the ActualTyreCompound as a string, or its number for unknown values
+func (v ActualTyreCompound) MarshalJSON() ([]byte, error) {
+	if name, ok := actualTyreCompoundNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the ActualTyreCompound from its name as a string, or its number
+func (v *ActualTyreCompound) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var visualTyreCompoundNames = map[VisualTyreCompound]string{
+	0:  "unknown",
+	7:  "intermediate",
+	8:  "wet",
+	15: "f2_wet",
+	16: "soft",
+	17: "medium",
+	18: "hard",
+	19: "f2_super_soft",
+	20: "f2_soft",
+	21: "f2_medium",
+	22: "f2_hard",
+}
+
+var visualTyreCompoundValues = map[string]VisualTyreCompound{
+	"unknown":       0,
+	"intermediate":  7,
+	"wet":           8,
+	"f2_wet":        15,
+	"soft":          16,
+	"medium":        17,
+	"hard":          18,
+	"f2_super_soft": 19,
+	"f2_soft":       20,
+	"f2_medium":     21,
+	"f2_hard":       22,
+}
+
+// String name of the VisualTyreCompound, or the type and number for unknown values
+func (v VisualTyreCompound) String() string {
+	if name, ok := visualTyreCompoundNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("VisualTyreCompound(%d)", v)
+}
+
+// MarshalText name of the VisualTyreCompound, or the number for unknown values
+func (v VisualTyreCompound) MarshalText() ([]byte, error) {
+	if name, ok := visualTyreCompoundNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the VisualTyreCompound from its name or number
+func (v *VisualTyreCompound) UnmarshalText(text []byte) error {
+	if val, ok := visualTyreCompoundValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown VisualTyreCompound %q", text)
+	}
+	*v = VisualTyreCompound(n)
+	return nil
+}
+
+// MarshalJSON name of the VisualTyreCompound as a string, or its number for unknown values
+func (v VisualTyreCompound) MarshalJSON() ([]byte, error) {
+	if name, ok := visualTyreCompoundNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the VisualTyreCompound from its name as a string, or its number
+func (v *VisualTyreCompound) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var ersDeployModeNames = map[ERSDeployMode]string{
+	0: "none",
+	1: "medium",
+	2: "overtake",
+	3: "hotlap",
+}
+
+var ersDeployModeValues = map[string]ERSDeployMode{
+	"none":     0,
+	"medium":   1,
+	"overtake": 2,
+	"hotlap":   3,
+}
+
+// String name of the ERSDeployMode, or the type and number for unknown values
+func (v ERSDeployMode) String() string {
+	if name, ok := ersDeployModeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("ERSDeployMode(%d)", v)
+}
+
+// MarshalText name of the ERSDeployMode, or the number for unknown values
+func (v ERSDeployMode) MarshalText() ([]byte, error) {
+	if name, ok := ersDeployModeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the ERSDeployMode from its name or number
+func (v *ERSDeployMode) UnmarshalText(text []byte) error {
+	if val, ok := ersDeployModeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown ERSDeployMode %q", text)
+	}
+	*v = ERSDeployMode(n)
+	return nil
+}
+
+// MarshalJSON name of the ERSDeployMode as a string, or its number for unknown values
+func (v ERSDeployMode) MarshalJSON() ([]byte, error) {
+	if name, ok := ersDeployModeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the ERSDeployMode from its name as a string, or its number
+func (v *ERSDeployMode) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
@@ -0,0 +1,105 @@
+package car_telemetry
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet car telemetry data
+type Packet struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// CarTelemetry telemetry for all cars on track
+	CarTelemetry [22]CarTelemetryData `json:"car_telemetry" packet:"0"`
+	// ButtonStatus buttons currently pressed by the player
+	ButtonStatus ButtonFlags `json:"button_status" packet:"1"`
+	// MFDPanelIndex panel open on the player's MFD
+	MFDPanelIndex MFDPanel `json:"mfd_panel_index" packet:"2"`
+	// MFDPanelIndexSecondaryPlayer panel open on the secondary player's MFD
+	MFDPanelIndexSecondaryPlayer MFDPanel `json:"mfd_panel_index_secondary_player" packet:"3"`
+	// SuggestedGear suggested gear for the player (1-8), 0 if no gear suggested
+	SuggestedGear int8 `json:"suggested_gear" packet:"4"`
+}
+
+// CarTelemetryData per-car telemetry data
+type CarTelemetryData struct {
+	// Speed speed of the car in km/h
+	Speed uint16 `json:"speed" packet:"0"`
+	// Throttle amount of throttle applied (0.0 to 1.0)
+	Throttle float32 `json:"throttle" packet:"1"`
+	// Steer steering (-1.0 full lock left to 1.0 full lock right)
+	Steer float32 `json:"steer" packet:"2"`
+	// Brake amount of brake applied (0.0 to 1.0)
+	Brake float32 `json:"brake" packet:"3"`
+	// Clutch amount of clutch applied (0 to 100)
+	Clutch uint8 `json:"clutch" packet:"4"`
+	// Gear gear selected (1-8, N=0, R=-1)
+	Gear int8 `json:"gear" packet:"5"`
+	// EngineRPM engine RPM
+	EngineRPM uint16 `json:"engine_rpm" packet:"6"`
+	// DRS whether DRS is open
+	DRS bool `json:"drs" packet:"7"`
+	// RevLightsPercent rev lights indicator percentage
+	RevLightsPercent uint8 `json:"rev_lights_percent" packet:"8"`
+	// BrakesTemperature brake temperature in degrees celsius
+	BrakesTemperature WheelDataUInt16 `json:"brakes_temperature" packet:"9"`
+	// TyresSurfaceTemperature tyre surface temperature in degrees celsius
+	TyresSurfaceTemperature WheelDataUInt8 `json:"tyres_surface_temperature" packet:"10"`
+	// TyresInnerTemperature tyre inner temperature in degrees celsius
+	TyresInnerTemperature WheelDataUInt8 `json:"tyres_inner_temperature" packet:"11"`
+	// EngineTemperature engine temperature in degrees celsius
+	EngineTemperature uint16 `json:"engine_temperature" packet:"12"`
+	// TyresPressure tyre pressure in PSI
+	TyresPressure WheelDataFloat `json:"tyres_pressure" packet:"13"`
+	// SurfaceType surface type each tyre is in contact with
+	SurfaceType WheelSurfaceTypes `json:"surface_type" packet:"14"`
+}
+
+// WheelDataFloat float data associated with all wheels on the car
+type WheelDataFloat struct {
+	// RearLeft Rear left wheel
+	RearLeft float32 `json:"rear_left" packet:"0"`
+	// RearRight Rear right wheel
+	RearRight float32 `json:"rear_right" packet:"1"`
+	// FrontLeft Front left wheel
+	FrontLeft float32 `json:"front_left" packet:"2"`
+	// FrontRight Front right wheel
+	FrontRight float32 `json:"front_right" packet:"3"`
+}
+
+// WheelDataUInt16 uint16 data associated with all wheels on the car
+type WheelDataUInt16 struct {
+	// RearLeft Rear left wheel
+	RearLeft uint16 `json:"rear_left" packet:"0"`
+	// RearRight Rear right wheel
+	RearRight uint16 `json:"rear_right" packet:"1"`
+	// FrontLeft Front left wheel
+	FrontLeft uint16 `json:"front_left" packet:"2"`
+	// FrontRight Front right wheel
+	FrontRight uint16 `json:"front_right" packet:"3"`
+}
+
+// WheelDataUInt8 uint8 data associated with all wheels on the car
+type WheelDataUInt8 struct {
+	// RearLeft Rear left wheel
+	RearLeft uint8 `json:"rear_left" packet:"0"`
+	// RearRight Rear right wheel
+	RearRight uint8 `json:"rear_right" packet:"1"`
+	// FrontLeft Front left wheel
+	FrontLeft uint8 `json:"front_left" packet:"2"`
+	// FrontRight Front right wheel
+	FrontRight uint8 `json:"front_right" packet:"3"`
+}
+
+// WheelSurfaceTypes surface type each wheel on the car is in contact with
+type WheelSurfaceTypes struct {
+	// RearLeft Rear left wheel
+	RearLeft SurfaceType `json:"rear_left" packet:"0"`
+	// RearRight Rear right wheel
+	RearRight SurfaceType `json:"rear_right" packet:"1"`
+	// FrontLeft Front left wheel
+	FrontLeft SurfaceType `json:"front_left" packet:"2"`
+	// FrontRight Front right wheel
+	FrontRight SurfaceType `json:"front_right" packet:"3"`
+}
@@ -0,0 +1,49 @@
+package car_telemetry
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet2019 F1 2019 car telemetry data
+type Packet2019 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// CarTelemetry telemetry for all cars on track
+	CarTelemetry [20]CarTelemetryData2019 `json:"car_telemetry" packet:"0"`
+	// ButtonStatus buttons currently pressed by the player
+	ButtonStatus ButtonFlags `json:"button_status" packet:"1"`
+}
+
+// CarTelemetryData2019 F1 2019 per-car telemetry data
+type CarTelemetryData2019 struct {
+	// Speed speed of the car in km/h
+	Speed uint16 `json:"speed" packet:"0"`
+	// Throttle amount of throttle applied (0.0 to 1.0)
+	Throttle float32 `json:"throttle" packet:"1"`
+	// Steer steering (-1.0 full lock left to 1.0 full lock right)
+	Steer float32 `json:"steer" packet:"2"`
+	// Brake amount of brake applied (0.0 to 1.0)
+	Brake float32 `json:"brake" packet:"3"`
+	// Clutch amount of clutch applied (0 to 100)
+	Clutch uint8 `json:"clutch" packet:"4"`
+	// Gear gear selected (1-8, N=0, R=-1)
+	Gear int8 `json:"gear" packet:"5"`
+	// EngineRPM engine RPM
+	EngineRPM uint16 `json:"engine_rpm" packet:"6"`
+	// DRS whether DRS is open
+	DRS bool `json:"drs" packet:"7"`
+	// RevLightsPercent rev lights indicator percentage
+	RevLightsPercent uint8 `json:"rev_lights_percent" packet:"8"`
+	// BrakesTemperature brake temperature in degrees celsius
+	BrakesTemperature WheelDataUInt16 `json:"brakes_temperature" packet:"9"`
+	// TyresSurfaceTemperature tyre surface temperature in degrees celsius
+	TyresSurfaceTemperature WheelDataUInt16 `json:"tyres_surface_temperature" packet:"10"`
+	// TyresInnerTemperature tyre inner temperature in degrees celsius
+	TyresInnerTemperature WheelDataUInt16 `json:"tyres_inner_temperature" packet:"11"`
+	// EngineTemperature engine temperature in degrees celsius
+	EngineTemperature uint16 `json:"engine_temperature" packet:"12"`
+	// TyresPressure tyre pressure in PSI
+	TyresPressure WheelDataFloat `json:"tyres_pressure" packet:"13"`
+	// SurfaceType surface type each tyre is in contact with
+	SurfaceType WheelSurfaceTypes `json:"surface_type" packet:"14"`
+}
@@ -0,0 +1,138 @@
+package car_telemetry_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/car_telemetry"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"testing"
+)
+
+// inputs append the fields up to RevLightsPercent of car i, which every format has
+func inputs(b *packettest.Builder, i int) {
+	b.U16(uint16(300 + i)).F32(1).F32(-0.25).F32(0.5).U8(uint8(i)).I8(int8(i%10 - 1)).U16(uint16(11000 + i)).Bool(i%2 == 0).U8(uint8(i * 4))
+}
+
+// tyrePressures append the tyre pressures and surface types of car i, which every format ends with
+func tyrePressures(b *packettest.Builder, i int) {
+	b.F32(22.5).F32(22.75).F32(23.5).F32(23.75)
+	b.U8(uint8(car_telemetry.SurfaceTypeTarmac)).U8(uint8(car_telemetry.SurfaceTypeRumbleStrip)).
+		U8(uint8(car_telemetry.SurfaceTypeGravel)).U8(uint8(i % 12))
+}
+
+// checkInputs whether car i holds the values appended by inputs and tyrePressures
+func checkInputs(t *testing.T, name string, i int, speed uint16, throttle, steer, brake float32, clutch uint8, gear int8,
+	engineRPM uint16, drs bool, revLights uint8, pressures car_telemetry.WheelDataFloat, surfaces car_telemetry.WheelSurfaceTypes) {
+	t.Helper()
+	if speed != uint16(300+i) || throttle != 1 || steer != -0.25 || brake != 0.5 || clutch != uint8(i) || gear != int8(i%10-1) {
+		t.Errorf("%v: car %v speed %v, throttle %v, steer %v, brake %v, clutch %v, gear %v", name, i, speed, throttle, steer, brake, clutch, gear)
+	}
+	if engineRPM != uint16(11000+i) || drs != (i%2 == 0) || revLights != uint8(i*4) {
+		t.Errorf("%v: car %v engine RPM %v, DRS %v, rev lights %v", name, i, engineRPM, drs, revLights)
+	}
+	wantPressures := car_telemetry.WheelDataFloat{RearLeft: 22.5, RearRight: 22.75, FrontLeft: 23.5, FrontRight: 23.75}
+	wantSurfaces := car_telemetry.WheelSurfaceTypes{
+		RearLeft:   car_telemetry.SurfaceTypeTarmac,
+		RearRight:  car_telemetry.SurfaceTypeRumbleStrip,
+		FrontLeft:  car_telemetry.SurfaceTypeGravel,
+		FrontRight: car_telemetry.SurfaceType(i % 12),
+	}
+	if pressures != wantPressures || surfaces != wantSurfaces {
+		t.Errorf("%v: car %v tyre pressures %+v on %+v", name, i, pressures, surfaces)
+	}
+}
+
+func TestGolden2019(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2019, common.PacketIDCarTelemetry)
+	b := &packettest.Builder{}
+	for i := 0; i < 20; i++ {
+		inputs(b, i)
+		b.U16(uint16(500 + i)).U16(uint16(510 + i)).U16(uint16(520 + i)).U16(uint16(530 + i))
+		b.U16(80).U16(81).U16(82).U16(uint16(83 + i))
+		b.U16(100).U16(101).U16(102).U16(uint16(103 + i))
+		b.U16(uint16(110 + i))
+		tyrePressures(b, i)
+	}
+	b.U32(uint32(car_telemetry.ButtonCircleOrB))
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*car_telemetry.Packet2019)
+	for i, c := range p.CarTelemetry {
+		checkInputs(t, layout.Name, i, c.Speed, c.Throttle, c.Steer, c.Brake, c.Clutch, c.Gear, c.EngineRPM, c.DRS, c.RevLightsPercent, c.TyresPressure, c.SurfaceType)
+		brakes := car_telemetry.WheelDataUInt16{RearLeft: uint16(500 + i), RearRight: uint16(510 + i), FrontLeft: uint16(520 + i), FrontRight: uint16(530 + i)}
+		surface := car_telemetry.WheelDataUInt16{RearLeft: 80, RearRight: 81, FrontLeft: 82, FrontRight: uint16(83 + i)}
+		inner := car_telemetry.WheelDataUInt16{RearLeft: 100, RearRight: 101, FrontLeft: 102, FrontRight: uint16(103 + i)}
+		if c.BrakesTemperature != brakes || c.TyresSurfaceTemperature != surface || c.TyresInnerTemperature != inner || c.EngineTemperature != uint16(110+i) {
+			t.Errorf("%v: car %v temperatures brakes %+v, tyre surface %+v, tyre inner %+v, engine %v",
+				layout.Name, i, c.BrakesTemperature, c.TyresSurfaceTemperature, c.TyresInnerTemperature, c.EngineTemperature)
+		}
+	}
+	if p.ButtonStatus != car_telemetry.ButtonCircleOrB {
+		t.Errorf("%v: button status %v", layout.Name, p.ButtonStatus)
+	}
+}
+
+// temperatures append the 2020 and later temperatures of car i, with single byte tyre temperatures
+func temperatures(b *packettest.Builder, i int) {
+	b.U16(uint16(500 + i)).U16(uint16(510 + i)).U16(uint16(520 + i)).U16(uint16(530 + i))
+	b.U8(80).U8(81).U8(82).U8(uint8(83 + i))
+	b.U8(100).U8(101).U8(102).U8(uint8(103 + i))
+	b.U16(uint16(110 + i))
+}
+
+// checkTemperatures whether car i holds the values appended by temperatures
+func checkTemperatures(t *testing.T, name string, i int, brakes car_telemetry.WheelDataUInt16, surface, inner car_telemetry.WheelDataUInt8, engine uint16) {
+	t.Helper()
+	wantBrakes := car_telemetry.WheelDataUInt16{RearLeft: uint16(500 + i), RearRight: uint16(510 + i), FrontLeft: uint16(520 + i), FrontRight: uint16(530 + i)}
+	wantSurface := car_telemetry.WheelDataUInt8{RearLeft: 80, RearRight: 81, FrontLeft: 82, FrontRight: uint8(83 + i)}
+	wantInner := car_telemetry.WheelDataUInt8{RearLeft: 100, RearRight: 101, FrontLeft: 102, FrontRight: uint8(103 + i)}
+	if brakes != wantBrakes || surface != wantSurface || inner != wantInner || engine != uint16(110+i) {
+		t.Errorf("%v: car %v temperatures brakes %+v, tyre surface %+v, tyre inner %+v, engine %v", name, i, brakes, surface, inner, engine)
+	}
+}
+
+func TestGolden2020(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2020, common.PacketIDCarTelemetry)
+	b := &packettest.Builder{}
+	for i := 0; i < 22; i++ {
+		inputs(b, i)
+		temperatures(b, i)
+		tyrePressures(b, i)
+	}
+	b.U32(uint32(car_telemetry.ButtonCrossOrA)).U8(uint8(car_telemetry.MFDPanelTemperatures)).U8(uint8(car_telemetry.MFDPanelClosed)).I8(7)
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*car_telemetry.Packet)
+	for i, c := range p.CarTelemetry {
+		checkInputs(t, layout.Name, i, c.Speed, c.Throttle, c.Steer, c.Brake, c.Clutch, c.Gear, c.EngineRPM, c.DRS, c.RevLightsPercent, c.TyresPressure, c.SurfaceType)
+		checkTemperatures(t, layout.Name, i, c.BrakesTemperature, c.TyresSurfaceTemperature, c.TyresInnerTemperature, c.EngineTemperature)
+	}
+	if p.ButtonStatus != car_telemetry.ButtonCrossOrA || p.MFDPanelIndex != car_telemetry.MFDPanelTemperatures ||
+		p.MFDPanelIndexSecondaryPlayer != car_telemetry.MFDPanelClosed || p.SuggestedGear != 7 {
+		t.Errorf("%v: buttons %v, MFD panels %v and %v, suggested gear %v",
+			layout.Name, p.ButtonStatus, p.MFDPanelIndex, p.MFDPanelIndexSecondaryPlayer, p.SuggestedGear)
+	}
+}
+
+func TestGolden2021(t *testing.T) {
+	for _, format := range []uint16{common.PacketFormat2021, common.PacketFormat2022} {
+		layout := packettest.LayoutOf(format, common.PacketIDCarTelemetry)
+		b := &packettest.Builder{}
+		for i := 0; i < 22; i++ {
+			inputs(b, i)
+			b.U16(uint16(0x7fff >> uint(i%15)))
+			temperatures(b, i)
+			tyrePressures(b, i)
+		}
+		b.U8(uint8(car_telemetry.MFDPanelTemperatures)).U8(uint8(car_telemetry.MFDPanelClosed)).I8(0)
+
+		p := packettest.Golden(t, layout, b.Bytes()).(*car_telemetry.Packet2021)
+		for i, c := range p.CarTelemetry {
+			checkInputs(t, layout.Name, i, c.Speed, c.Throttle, c.Steer, c.Brake, c.Clutch, c.Gear, c.EngineRPM, c.DRS, c.RevLightsPercent, c.TyresPressure, c.SurfaceType)
+			checkTemperatures(t, layout.Name, i, c.BrakesTemperature, c.TyresSurfaceTemperature, c.TyresInnerTemperature, c.EngineTemperature)
+			if c.RevLightsBitValue != uint16(0x7fff>>uint(i%15)) {
+				t.Errorf("%v: car %v rev lights bits %#x", layout.Name, i, c.RevLightsBitValue)
+			}
+		}
+		if p.MFDPanelIndex != car_telemetry.MFDPanelTemperatures || p.MFDPanelIndexSecondaryPlayer != car_telemetry.MFDPanelClosed || p.SuggestedGear != 0 {
+			t.Errorf("%v: MFD panels %v and %v, suggested gear %v", layout.Name, p.MFDPanelIndex, p.MFDPanelIndexSecondaryPlayer, p.SuggestedGear)
+		}
+	}
+}
@@ -0,0 +1,343 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package car_telemetry
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// DecodeFrom decode CarTelemetryData from its wire format, returning the number of bytes read
+func (p *CarTelemetryData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 58 {
+		return 0, fmt.Errorf("unable to decode CarTelemetryData: need 58 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 58, nil
+}
+
+// PacketSize size of CarTelemetryData on the wire in bytes
+func (p *CarTelemetryData) PacketSize() int {
+	return 58
+}
+
+// decode decode CarTelemetryData from data holding at least 58 bytes
+func (p *CarTelemetryData) decode(data []byte) error {
+	p.Speed = uint16(binary.LittleEndian.Uint16(data[0:]))
+	p.Throttle = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[2:])))
+	p.Steer = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[6:])))
+	p.Brake = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[10:])))
+	p.Clutch = uint8(data[14])
+	p.Gear = int8(data[15])
+	p.EngineRPM = uint16(binary.LittleEndian.Uint16(data[16:]))
+	if data[18] > 1 {
+		return fmt.Errorf("failed to set DRS value: unexpected byte value %v is <0 or >1", data[18])
+	}
+	p.DRS = bool(data[18] == 1)
+	p.RevLightsPercent = uint8(data[19])
+	if err := p.BrakesTemperature.decode(data[20:]); err != nil {
+		return fmt.Errorf("unable to set struct field BrakesTemperature: %v", err)
+	}
+	if err := p.TyresSurfaceTemperature.decode(data[28:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresSurfaceTemperature: %v", err)
+	}
+	if err := p.TyresInnerTemperature.decode(data[32:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresInnerTemperature: %v", err)
+	}
+	p.EngineTemperature = uint16(binary.LittleEndian.Uint16(data[36:]))
+	if err := p.TyresPressure.decode(data[38:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresPressure: %v", err)
+	}
+	if err := p.SurfaceType.decode(data[54:]); err != nil {
+		return fmt.Errorf("unable to set struct field SurfaceType: %v", err)
+	}
+	return nil
+}
+
+// DecodeFrom decode CarTelemetryData2019 from its wire format, returning the number of bytes read
+func (p *CarTelemetryData2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 66 {
+		return 0, fmt.Errorf("unable to decode CarTelemetryData2019: need 66 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 66, nil
+}
+
+// PacketSize size of CarTelemetryData2019 on the wire in bytes
+func (p *CarTelemetryData2019) PacketSize() int {
+	return 66
+}
+
+// decode decode CarTelemetryData2019 from data holding at least 66 bytes
+func (p *CarTelemetryData2019) decode(data []byte) error {
+	p.Speed = uint16(binary.LittleEndian.Uint16(data[0:]))
+	p.Throttle = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[2:])))
+	p.Steer = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[6:])))
+	p.Brake = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[10:])))
+	p.Clutch = uint8(data[14])
+	p.Gear = int8(data[15])
+	p.EngineRPM = uint16(binary.LittleEndian.Uint16(data[16:]))
+	if data[18] > 1 {
+		return fmt.Errorf("failed to set DRS value: unexpected byte value %v is <0 or >1", data[18])
+	}
+	p.DRS = bool(data[18] == 1)
+	p.RevLightsPercent = uint8(data[19])
+	if err := p.BrakesTemperature.decode(data[20:]); err != nil {
+		return fmt.Errorf("unable to set struct field BrakesTemperature: %v", err)
+	}
+	if err := p.TyresSurfaceTemperature.decode(data[28:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresSurfaceTemperature: %v", err)
+	}
+	if err := p.TyresInnerTemperature.decode(data[36:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresInnerTemperature: %v", err)
+	}
+	p.EngineTemperature = uint16(binary.LittleEndian.Uint16(data[44:]))
+	if err := p.TyresPressure.decode(data[46:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresPressure: %v", err)
+	}
+	if err := p.SurfaceType.decode(data[62:]); err != nil {
+		return fmt.Errorf("unable to set struct field SurfaceType: %v", err)
+	}
+	return nil
+}
+
+// DecodeFrom decode CarTelemetryData2021 from its wire format, returning the number of bytes read
+func (p *CarTelemetryData2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 60 {
+		return 0, fmt.Errorf("unable to decode CarTelemetryData2021: need 60 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 60, nil
+}
+
+// PacketSize size of CarTelemetryData2021 on the wire in bytes
+func (p *CarTelemetryData2021) PacketSize() int {
+	return 60
+}
+
+// decode decode CarTelemetryData2021 from data holding at least 60 bytes
+func (p *CarTelemetryData2021) decode(data []byte) error {
+	p.Speed = uint16(binary.LittleEndian.Uint16(data[0:]))
+	p.Throttle = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[2:])))
+	p.Steer = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[6:])))
+	p.Brake = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[10:])))
+	p.Clutch = uint8(data[14])
+	p.Gear = int8(data[15])
+	p.EngineRPM = uint16(binary.LittleEndian.Uint16(data[16:]))
+	if data[18] > 1 {
+		return fmt.Errorf("failed to set DRS value: unexpected byte value %v is <0 or >1", data[18])
+	}
+	p.DRS = bool(data[18] == 1)
+	p.RevLightsPercent = uint8(data[19])
+	p.RevLightsBitValue = uint16(binary.LittleEndian.Uint16(data[20:]))
+	if err := p.BrakesTemperature.decode(data[22:]); err != nil {
+		return fmt.Errorf("unable to set struct field BrakesTemperature: %v", err)
+	}
+	if err := p.TyresSurfaceTemperature.decode(data[30:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresSurfaceTemperature: %v", err)
+	}
+	if err := p.TyresInnerTemperature.decode(data[34:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresInnerTemperature: %v", err)
+	}
+	p.EngineTemperature = uint16(binary.LittleEndian.Uint16(data[38:]))
+	if err := p.TyresPressure.decode(data[40:]); err != nil {
+		return fmt.Errorf("unable to set struct field TyresPressure: %v", err)
+	}
+	if err := p.SurfaceType.decode(data[56:]); err != nil {
+		return fmt.Errorf("unable to set struct field SurfaceType: %v", err)
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1283 {
+		return 0, fmt.Errorf("unable to decode Packet: need 1283 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1283, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 1283
+}
+
+// decode decode Packet from data holding at least 1283 bytes
+func (p *Packet) decode(data []byte) error {
+	for i0 := range p.CarTelemetry {
+		if err := p.CarTelemetry[i0].decode(data[0+i0*58:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarTelemetry item: %v", err)
+		}
+	}
+	p.ButtonStatus = ButtonFlags(binary.LittleEndian.Uint32(data[1276:]))
+	p.MFDPanelIndex = MFDPanel(data[1280])
+	p.MFDPanelIndexSecondaryPlayer = MFDPanel(data[1281])
+	p.SuggestedGear = int8(data[1282])
+	return nil
+}
+
+// DecodeFrom decode Packet2019 from its wire format, returning the number of bytes read
+func (p *Packet2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1324 {
+		return 0, fmt.Errorf("unable to decode Packet2019: need 1324 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1324, nil
+}
+
+// PacketSize size of Packet2019 on the wire in bytes
+func (p *Packet2019) PacketSize() int {
+	return 1324
+}
+
+// decode decode Packet2019 from data holding at least 1324 bytes
+func (p *Packet2019) decode(data []byte) error {
+	for i0 := range p.CarTelemetry {
+		if err := p.CarTelemetry[i0].decode(data[0+i0*66:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarTelemetry item: %v", err)
+		}
+	}
+	p.ButtonStatus = ButtonFlags(binary.LittleEndian.Uint32(data[1320:]))
+	return nil
+}
+
+// DecodeFrom decode Packet2021 from its wire format, returning the number of bytes read
+func (p *Packet2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1323 {
+		return 0, fmt.Errorf("unable to decode Packet2021: need 1323 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1323, nil
+}
+
+// PacketSize size of Packet2021 on the wire in bytes
+func (p *Packet2021) PacketSize() int {
+	return 1323
+}
+
+// decode decode Packet2021 from data holding at least 1323 bytes
+func (p *Packet2021) decode(data []byte) error {
+	for i0 := range p.CarTelemetry {
+		if err := p.CarTelemetry[i0].decode(data[0+i0*60:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarTelemetry item: %v", err)
+		}
+	}
+	p.MFDPanelIndex = MFDPanel(data[1320])
+	p.MFDPanelIndexSecondaryPlayer = MFDPanel(data[1321])
+	p.SuggestedGear = int8(data[1322])
+	return nil
+}
+
+// DecodeFrom decode WheelDataFloat from its wire format, returning the number of bytes read
+func (p *WheelDataFloat) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 16 {
+		return 0, fmt.Errorf("unable to decode WheelDataFloat: need 16 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 16, nil
+}
+
+// PacketSize size of WheelDataFloat on the wire in bytes
+func (p *WheelDataFloat) PacketSize() int {
+	return 16
+}
+
+// decode decode WheelDataFloat from data holding at least 16 bytes
+func (p *WheelDataFloat) decode(data []byte) error {
+	p.RearLeft = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[0:])))
+	p.RearRight = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[4:])))
+	p.FrontLeft = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[8:])))
+	p.FrontRight = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[12:])))
+	return nil
+}
+
+// DecodeFrom decode WheelDataUInt16 from its wire format, returning the number of bytes read
+func (p *WheelDataUInt16) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 8 {
+		return 0, fmt.Errorf("unable to decode WheelDataUInt16: need 8 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 8, nil
+}
+
+// PacketSize size of WheelDataUInt16 on the wire in bytes
+func (p *WheelDataUInt16) PacketSize() int {
+	return 8
+}
+
+// decode decode WheelDataUInt16 from data holding at least 8 bytes
+func (p *WheelDataUInt16) decode(data []byte) error {
+	p.RearLeft = uint16(binary.LittleEndian.Uint16(data[0:]))
+	p.RearRight = uint16(binary.LittleEndian.Uint16(data[2:]))
+	p.FrontLeft = uint16(binary.LittleEndian.Uint16(data[4:]))
+	p.FrontRight = uint16(binary.LittleEndian.Uint16(data[6:]))
+	return nil
+}
+
+// DecodeFrom decode WheelDataUInt8 from its wire format, returning the number of bytes read
+func (p *WheelDataUInt8) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, fmt.Errorf("unable to decode WheelDataUInt8: need 4 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 4, nil
+}
+
+// PacketSize size of WheelDataUInt8 on the wire in bytes
+func (p *WheelDataUInt8) PacketSize() int {
+	return 4
+}
+
+// decode decode WheelDataUInt8 from data holding at least 4 bytes
+func (p *WheelDataUInt8) decode(data []byte) error {
+	p.RearLeft = uint8(data[0])
+	p.RearRight = uint8(data[1])
+	p.FrontLeft = uint8(data[2])
+	p.FrontRight = uint8(data[3])
+	return nil
+}
+
+// DecodeFrom decode WheelSurfaceTypes from its wire format, returning the number of bytes read
+func (p *WheelSurfaceTypes) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, fmt.Errorf("unable to decode WheelSurfaceTypes: need 4 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 4, nil
+}
+
+// PacketSize size of WheelSurfaceTypes on the wire in bytes
+func (p *WheelSurfaceTypes) PacketSize() int {
+	return 4
+}
+
+// decode decode WheelSurfaceTypes from data holding at least 4 bytes
+func (p *WheelSurfaceTypes) decode(data []byte) error {
+	p.RearLeft = SurfaceType(data[0])
+	p.RearRight = SurfaceType(data[1])
+	p.FrontLeft = SurfaceType(data[2])
+	p.FrontRight = SurfaceType(data[3])
+	return nil
+}
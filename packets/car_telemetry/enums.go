@@ -0,0 +1,92 @@
+package car_telemetry
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/enum-gen
+
+// SurfaceType type of surface a tyre is in contact with
+type SurfaceType uint8
+
+const (
+	// SurfaceTypeTarmac tarmac
+	SurfaceTypeTarmac SurfaceType = 0
+	// SurfaceTypeRumbleStrip rumble strip
+	SurfaceTypeRumbleStrip SurfaceType = 1
+	// SurfaceTypeConcrete concrete
+	SurfaceTypeConcrete SurfaceType = 2
+	// SurfaceTypeRock rock
+	SurfaceTypeRock SurfaceType = 3
+	// SurfaceTypeGravel gravel
+	SurfaceTypeGravel SurfaceType = 4
+	// SurfaceTypeMud mud
+	SurfaceTypeMud SurfaceType = 5
+	// SurfaceTypeSand sand
+	SurfaceTypeSand SurfaceType = 6
+	// SurfaceTypeGrass grass
+	SurfaceTypeGrass SurfaceType = 7
+	// SurfaceTypeWater water
+	SurfaceTypeWater SurfaceType = 8
+	// SurfaceTypeCobblestone cobblestone
+	SurfaceTypeCobblestone SurfaceType = 9
+	// SurfaceTypeMetal metal
+	SurfaceTypeMetal SurfaceType = 10
+	// SurfaceTypeRidged ridged
+	SurfaceTypeRidged SurfaceType = 11
+)
+
+// MFDPanel panel currently open on the multi-function display
+type MFDPanel uint8
+
+const (
+	// MFDPanelCarSetup car setup panel
+	MFDPanelCarSetup MFDPanel = 0
+	// MFDPanelPits pits panel
+	MFDPanelPits MFDPanel = 1
+	// MFDPanelDamage damage panel
+	MFDPanelDamage MFDPanel = 2
+	// MFDPanelEngine engine panel
+	MFDPanelEngine MFDPanel = 3
+	// MFDPanelTemperatures temperatures panel
+	MFDPanelTemperatures MFDPanel = 4
+	// MFDPanelClosed MFD is closed
+	MFDPanelClosed MFDPanel = 255
+)
+
+// ButtonFlags bit flags of the buttons currently pressed
+type ButtonFlags uint32
+
+const (
+	// ButtonCrossOrA cross or A
+	ButtonCrossOrA ButtonFlags = 0x0001
+	// ButtonTriangleOrY triangle or Y
+	ButtonTriangleOrY ButtonFlags = 0x0002
+	// ButtonCircleOrB circle or B
+	ButtonCircleOrB ButtonFlags = 0x0004
+	// ButtonSquareOrX square or X
+	ButtonSquareOrX ButtonFlags = 0x0008
+	// ButtonDPadLeft d-pad left
+	ButtonDPadLeft ButtonFlags = 0x0010
+	// ButtonDPadRight d-pad right
+	ButtonDPadRight ButtonFlags = 0x0020
+	// ButtonDPadUp d-pad up
+	ButtonDPadUp ButtonFlags = 0x0040
+	// ButtonDPadDown d-pad down
+	ButtonDPadDown ButtonFlags = 0x0080
+	// ButtonOptionsOrMenu options or menu
+	ButtonOptionsOrMenu ButtonFlags = 0x0100
+	// ButtonL1OrLB L1 or LB
+	ButtonL1OrLB ButtonFlags = 0x0200
+	// ButtonR1OrRB R1 or RB
+	ButtonR1OrRB ButtonFlags = 0x0400
+	// ButtonL2OrLT L2 or LT
+	ButtonL2OrLT ButtonFlags = 0x0800
+	// ButtonR2OrRT R2 or RT
+	ButtonR2OrRT ButtonFlags = 0x1000
+	// ButtonLeftStickClick left stick click
+	ButtonLeftStickClick ButtonFlags = 0x2000
+	// ButtonRightStickClick right stick click
+	ButtonRightStickClick ButtonFlags = 0x4000
+)
+
+// Has whether the given button is pressed
+func (b ButtonFlags) Has(button ButtonFlags) bool {
+	return b&button == button
+}
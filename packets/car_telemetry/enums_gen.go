@@ -0,0 +1,151 @@
+// Code generated by enum-gen. DO NOT EDIT.
+
+package car_telemetry
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var surfaceTypeNames = map[SurfaceType]string{
+	0:  "tarmac",
+	1:  "rumble_strip",
+	2:  "concrete",
+	3:  "rock",
+	4:  "gravel",
+	5:  "mud",
+	6:  "sand",
+	7:  "grass",
+	8:  "water",
+	9:  "cobblestone",
+	10: "metal",
+	11: "ridged",
+}
+
+var surfaceTypeValues = map[string]SurfaceType{
+	"tarmac":       0,
+	"rumble_strip": 1,
+	"concrete":     2,
+	"rock":         3,
+	"gravel":       4,
+	"mud":          5,
+	"sand":         6,
+	"grass":        7,
+	"water":        8,
+	"cobblestone":  9,
+	"metal":        10,
+	"ridged":       11,
+}
+
+// String name of the SurfaceType, or the type and number for unknown values
+func (v SurfaceType) String() string {
+	if name, ok := surfaceTypeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("SurfaceType(%d)", v)
+}
+
+// MarshalText name of the SurfaceType, or the number for unknown values
+func (v SurfaceType) MarshalText() ([]byte, error) {
+	if name, ok := surfaceTypeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the SurfaceType from its name or number
+func (v *SurfaceType) UnmarshalText(text []byte) error {
+	if val, ok := surfaceTypeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown SurfaceType %q", text)
+	}
+	*v = SurfaceType(n)
+	return nil
+}
+
+// MarshalJSON name of the SurfaceType as a string, or its number for unknown values
+func (v SurfaceType) MarshalJSON() ([]byte, error) {
+	if name, ok := surfaceTypeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the SurfaceType from its name as a string, or its number
+func (v *SurfaceType) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var mfdPanelNames = map[MFDPanel]string{
+	0:   "car_setup",
+	1:   "pits",
+	2:   "damage",
+	3:   "engine",
+	4:   "temperatures",
+	255: "closed",
+}
+
+var mfdPanelValues = map[string]MFDPanel{
+	"car_setup":    0,
+	"pits":         1,
+	"damage":       2,
+	"engine":       3,
+	"temperatures": 4,
+	"closed":       255,
+}
+
+// String name of the MFDPanel, or the type and number for unknown values
+func (v MFDPanel) String() string {
+	if name, ok := mfdPanelNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("MFDPanel(%d)", v)
+}
+
+// MarshalText name of the MFDPanel, or the number for unknown values
+func (v MFDPanel) MarshalText() ([]byte, error) {
+	if name, ok := mfdPanelNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the MFDPanel from its name or number
+func (v *MFDPanel) UnmarshalText(text []byte) error {
+	if val, ok := mfdPanelValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown MFDPanel %q", text)
+	}
+	*v = MFDPanel(n)
+	return nil
+}
+
+// MarshalJSON name of the MFDPanel as a string, or its number for unknown values
+func (v MFDPanel) MarshalJSON() ([]byte, error) {
+	if name, ok := mfdPanelNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the MFDPanel from its name as a string, or its number
+func (v *MFDPanel) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
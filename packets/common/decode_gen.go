@@ -0,0 +1,103 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package common
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// DecodeFrom decode Header from its wire format, returning the number of bytes read
+func (p *Header) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 24 {
+		return 0, fmt.Errorf("unable to decode Header: need 24 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 24, nil
+}
+
+// PacketSize size of Header on the wire in bytes
+func (p *Header) PacketSize() int {
+	return 24
+}
+
+// decode decode Header from data holding at least 24 bytes
+func (p *Header) decode(data []byte) error {
+	p.PacketFormat = uint16(binary.LittleEndian.Uint16(data[0:]))
+	p.GameMajorVersion = uint8(data[2])
+	p.GameMinorVersion = uint8(data[3])
+	p.PacketVersion = uint8(data[4])
+	p.PacketID = PacketID(data[5])
+	p.SessionUID = uint64(binary.LittleEndian.Uint64(data[6:]))
+	p.SessionTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[14:])))
+	p.FrameIdentifier = uint32(binary.LittleEndian.Uint32(data[18:]))
+	p.PlayerCarIndex = uint8(data[22])
+	p.SecondaryPlayerCarIndex = uint8(data[23])
+	return nil
+}
+
+// DecodeFrom decode Header2019 from its wire format, returning the number of bytes read
+func (p *Header2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 23 {
+		return 0, fmt.Errorf("unable to decode Header2019: need 23 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 23, nil
+}
+
+// PacketSize size of Header2019 on the wire in bytes
+func (p *Header2019) PacketSize() int {
+	return 23
+}
+
+// decode decode Header2019 from data holding at least 23 bytes
+func (p *Header2019) decode(data []byte) error {
+	p.PacketFormat = uint16(binary.LittleEndian.Uint16(data[0:]))
+	p.GameMajorVersion = uint8(data[2])
+	p.GameMinorVersion = uint8(data[3])
+	p.PacketVersion = uint8(data[4])
+	p.PacketID = PacketID(data[5])
+	p.SessionUID = uint64(binary.LittleEndian.Uint64(data[6:]))
+	p.SessionTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[14:])))
+	p.FrameIdentifier = uint32(binary.LittleEndian.Uint32(data[18:]))
+	p.PlayerCarIndex = uint8(data[22])
+	return nil
+}
+
+// DecodeFrom decode Header2023 from its wire format, returning the number of bytes read
+func (p *Header2023) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 29 {
+		return 0, fmt.Errorf("unable to decode Header2023: need 29 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 29, nil
+}
+
+// PacketSize size of Header2023 on the wire in bytes
+func (p *Header2023) PacketSize() int {
+	return 29
+}
+
+// decode decode Header2023 from data holding at least 29 bytes
+func (p *Header2023) decode(data []byte) error {
+	p.PacketFormat = uint16(binary.LittleEndian.Uint16(data[0:]))
+	p.GameYear = uint8(data[2])
+	p.GameMajorVersion = uint8(data[3])
+	p.GameMinorVersion = uint8(data[4])
+	p.PacketVersion = uint8(data[5])
+	p.PacketID = PacketID(data[6])
+	p.SessionUID = uint64(binary.LittleEndian.Uint64(data[7:]))
+	p.SessionTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[15:])))
+	p.FrameIdentifier = uint32(binary.LittleEndian.Uint32(data[19:]))
+	p.OverallFrameIdentifier = uint32(binary.LittleEndian.Uint32(data[23:]))
+	p.PlayerCarIndex = uint8(data[27])
+	p.SecondaryPlayerCarIndex = uint8(data[28])
+	return nil
+}
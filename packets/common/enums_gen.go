@@ -0,0 +1,86 @@
+// Code generated by enum-gen. DO NOT EDIT.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var packetIDNames = map[PacketID]string{
+	0:  "motion",
+	1:  "session",
+	2:  "lap_data",
+	3:  "event",
+	4:  "participants",
+	5:  "car_setups",
+	6:  "car_telemetry",
+	7:  "car_status",
+	8:  "final_classification",
+	9:  "lobby_info",
+	10: "car_damage",
+	11: "session_history",
+}
+
+var packetIDValues = map[string]PacketID{
+	"motion":               0,
+	"session":              1,
+	"lap_data":             2,
+	"event":                3,
+	"participants":         4,
+	"car_setups":           5,
+	"car_telemetry":        6,
+	"car_status":           7,
+	"final_classification": 8,
+	"lobby_info":           9,
+	"car_damage":           10,
+	"session_history":      11,
+}
+
+// String name of the PacketID, or the type and number for unknown values
+func (v PacketID) String() string {
+	if name, ok := packetIDNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("PacketID(%d)", v)
+}
+
+// MarshalText name of the PacketID, or the number for unknown values
+func (v PacketID) MarshalText() ([]byte, error) {
+	if name, ok := packetIDNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the PacketID from its name or number
+func (v *PacketID) UnmarshalText(text []byte) error {
+	if val, ok := packetIDValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown PacketID %q", text)
+	}
+	*v = PacketID(n)
+	return nil
+}
+
+// MarshalJSON name of the PacketID as a string, or its number for unknown values
+func (v PacketID) MarshalJSON() ([]byte, error) {
+	if name, ok := packetIDNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the PacketID from its name as a string, or its number
+func (v *PacketID) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
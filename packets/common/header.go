@@ -0,0 +1,210 @@
+package common
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/enum-gen
+
+// PacketID Identifier for the type of packet parsed
+type PacketID uint8
+
+const (
+	// PacketIDMotion Motion data for player's car - only sent while the player is in control
+	PacketIDMotion PacketID = 0
+	// PacketIDSession Data about the session - track, time left
+	PacketIDSession PacketID = 1
+	// PacketIDLapData Data about all the lap times of cars in the session
+	PacketIDLapData PacketID = 2
+	// PacketIDEvent Various notable events that happen during a session
+	PacketIDEvent PacketID = 3
+	// PacketIDParticipants List of participants in the session, mostly relevant for multiplayer
+	PacketIDParticipants PacketID = 4
+	// PacketIDCarSetups Details of car setups in the race
+	PacketIDCarSetups PacketID = 5
+	// PacketIDCarTelemetry Telemetry data for all cars
+	PacketIDCarTelemetry PacketID = 6
+	// PacketIDCarStatus Status data for all cars such as damage
+	PacketIDCarStatus PacketID = 7
+	// PacketIDFinalClassification Final classification confirmation at the end of a race
+	PacketIDFinalClassification PacketID = 8
+	// PacketIDLobbyInfo Information about players in a multiplayer lobby
+	PacketIDLobbyInfo PacketID = 9
+	// PacketIDCarDamage Damage status for all cars - F1 2021 onwards
+	PacketIDCarDamage PacketID = 10
+	// PacketIDSessionHistory Lap and tyre data for session - F1 2021 onwards
+	PacketIDSessionHistory PacketID = 11
+)
+
+const (
+	// PacketFormat2019 packets sent by F1 2019
+	PacketFormat2019 uint16 = 2019
+	// PacketFormat2020 packets sent by F1 2020
+	PacketFormat2020 uint16 = 2020
+	// PacketFormat2021 packets sent by F1 2021
+	PacketFormat2021 uint16 = 2021
+	// PacketFormat2022 packets sent by F1 2022
+	PacketFormat2022 uint16 = 2022
+	// PacketFormat2023 packets sent by F1 2023
+	PacketFormat2023 uint16 = 2023
+)
+
+// NoSecondaryPlayer SecondaryPlayerCarIndex when there is no second player
+const NoSecondaryPlayer uint8 = 255
+
+// Header format for F1 2020 telemetry data, also used by F1 2021 and F1 2022.
+// Headers of other formats are converted into this layout once decoded.
+type Header struct {
+	// Header specifying packet format
+	// i.e. 2020
+	PacketFormat uint16 `json:"packet_format" packet:"0"`
+	// GameMajorVersion major version identifier I.E. "X.00"
+	GameMajorVersion uint8 `json:"game_major_version" packet:"1"`
+	// GameMinorVersion minor version identifier "1.XX"
+	GameMinorVersion uint8 `json:"game_minor_version" packet:"2"`
+	// PacketVersion version of the packet type, all start from 1
+	PacketVersion uint8 `json:"packet_version" packet:"3"`
+	// PacketID type of the packet returned
+	PacketID PacketID `json:"packet_id" packet:"4"`
+	// SessionUID unique identifier for the session
+	SessionUID uint64 `json:"session_uid" packet:"5"`
+	// SessionTime timestamp of the session
+	SessionTime float32 `json:"session_time" packet:"6"`
+	// FrameIdentifier the frame the data was retrieved on
+	FrameIdentifier uint32 `json:"frame_identifier" packet:"7"`
+	// PlayerCarIndex the player's car in the array
+	PlayerCarIndex uint8 `json:"player_car_index" packet:"8"`
+	// SecondaryPlayerCarIndex the secondary player's car in the array (splitscreen)
+	// 255 if no second player
+	SecondaryPlayerCarIndex uint8 `json:"secondary_player_car_index" packet:"9"`
+
+	// GameYear last two digits of the game year, i.e. 23 - only sent from F1 2023
+	GameYear uint8 `json:"game_year,omitempty"`
+	// OverallFrameIdentifier frame identifier which doesn't go back after flashbacks - only sent from F1 2023
+	OverallFrameIdentifier uint32 `json:"overall_frame_identifier,omitempty"`
+}
+
+// Header the header itself, so every header layout can be converted in the same way
+func (h Header) Header() Header {
+	return h
+}
+
+// SetHeader set from the common header layout
+func (h *Header) SetHeader(header Header) {
+	*h = header
+}
+
+// Header2019 format for F1 2019 telemetry data
+type Header2019 struct {
+	// PacketFormat i.e. 2019
+	PacketFormat uint16 `packet:"0"`
+	// GameMajorVersion major version identifier I.E. "X.00"
+	GameMajorVersion uint8 `packet:"1"`
+	// GameMinorVersion minor version identifier "1.XX"
+	GameMinorVersion uint8 `packet:"2"`
+	// PacketVersion version of the packet type, all start from 1
+	PacketVersion uint8 `packet:"3"`
+	// PacketID type of the packet returned
+	PacketID PacketID `packet:"4"`
+	// SessionUID unique identifier for the session
+	SessionUID uint64 `packet:"5"`
+	// SessionTime timestamp of the session
+	SessionTime float32 `packet:"6"`
+	// FrameIdentifier the frame the data was retrieved on
+	FrameIdentifier uint32 `packet:"7"`
+	// PlayerCarIndex the player's car in the array
+	PlayerCarIndex uint8 `packet:"8"`
+}
+
+// Header converts to the common header layout
+func (h Header2019) Header() Header {
+	return Header{
+		PacketFormat:            h.PacketFormat,
+		GameMajorVersion:        h.GameMajorVersion,
+		GameMinorVersion:        h.GameMinorVersion,
+		PacketVersion:           h.PacketVersion,
+		PacketID:                h.PacketID,
+		SessionUID:              h.SessionUID,
+		SessionTime:             h.SessionTime,
+		FrameIdentifier:         h.FrameIdentifier,
+		PlayerCarIndex:          h.PlayerCarIndex,
+		SecondaryPlayerCarIndex: NoSecondaryPlayer,
+	}
+}
+
+// SetHeader set from the common header layout, the secondary player is dropped
+func (h *Header2019) SetHeader(header Header) {
+	*h = Header2019{
+		PacketFormat:     header.PacketFormat,
+		GameMajorVersion: header.GameMajorVersion,
+		GameMinorVersion: header.GameMinorVersion,
+		PacketVersion:    header.PacketVersion,
+		PacketID:         header.PacketID,
+		SessionUID:       header.SessionUID,
+		SessionTime:      header.SessionTime,
+		FrameIdentifier:  header.FrameIdentifier,
+		PlayerCarIndex:   header.PlayerCarIndex,
+	}
+}
+
+// Header2023 format for F1 2023 telemetry data
+type Header2023 struct {
+	// PacketFormat i.e. 2023
+	PacketFormat uint16 `packet:"0"`
+	// GameYear last two digits of the game year, i.e. 23
+	GameYear uint8 `packet:"1"`
+	// GameMajorVersion major version identifier I.E. "X.00"
+	GameMajorVersion uint8 `packet:"2"`
+	// GameMinorVersion minor version identifier "1.XX"
+	GameMinorVersion uint8 `packet:"3"`
+	// PacketVersion version of the packet type, all start from 1
+	PacketVersion uint8 `packet:"4"`
+	// PacketID type of the packet returned
+	PacketID PacketID `packet:"5"`
+	// SessionUID unique identifier for the session
+	SessionUID uint64 `packet:"6"`
+	// SessionTime timestamp of the session
+	SessionTime float32 `packet:"7"`
+	// FrameIdentifier the frame the data was retrieved on
+	FrameIdentifier uint32 `packet:"8"`
+	// OverallFrameIdentifier frame identifier which doesn't go back after flashbacks
+	OverallFrameIdentifier uint32 `packet:"9"`
+	// PlayerCarIndex the player's car in the array
+	PlayerCarIndex uint8 `packet:"10"`
+	// SecondaryPlayerCarIndex the secondary player's car in the array (splitscreen)
+	// 255 if no second player
+	SecondaryPlayerCarIndex uint8 `packet:"11"`
+}
+
+// Header converts to the common header layout
+func (h Header2023) Header() Header {
+	return Header{
+		PacketFormat:            h.PacketFormat,
+		GameMajorVersion:        h.GameMajorVersion,
+		GameMinorVersion:        h.GameMinorVersion,
+		PacketVersion:           h.PacketVersion,
+		PacketID:                h.PacketID,
+		SessionUID:              h.SessionUID,
+		SessionTime:             h.SessionTime,
+		FrameIdentifier:         h.FrameIdentifier,
+		PlayerCarIndex:          h.PlayerCarIndex,
+		SecondaryPlayerCarIndex: h.SecondaryPlayerCarIndex,
+		GameYear:                h.GameYear,
+		OverallFrameIdentifier:  h.OverallFrameIdentifier,
+	}
+}
+
+// SetHeader set from the common header layout
+func (h *Header2023) SetHeader(header Header) {
+	*h = Header2023{
+		PacketFormat:            header.PacketFormat,
+		GameYear:                header.GameYear,
+		GameMajorVersion:        header.GameMajorVersion,
+		GameMinorVersion:        header.GameMinorVersion,
+		PacketVersion:           header.PacketVersion,
+		PacketID:                header.PacketID,
+		SessionUID:              header.SessionUID,
+		SessionTime:             header.SessionTime,
+		FrameIdentifier:         header.FrameIdentifier,
+		OverallFrameIdentifier:  header.OverallFrameIdentifier,
+		PlayerCarIndex:          header.PlayerCarIndex,
+		SecondaryPlayerCarIndex: header.SecondaryPlayerCarIndex,
+	}
+}
@@ -0,0 +1,325 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package event
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets/car_telemetry"
+	"math"
+)
+
+// DecodeFrom decode Buttons from its wire format, returning the number of bytes read
+func (p *Buttons) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, fmt.Errorf("unable to decode Buttons: need 4 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 4, nil
+}
+
+// PacketSize size of Buttons on the wire in bytes
+func (p *Buttons) PacketSize() int {
+	return 4
+}
+
+// decode decode Buttons from data holding at least 4 bytes
+func (p *Buttons) decode(data []byte) error {
+	p.ButtonStatus = car_telemetry.ButtonFlags(binary.LittleEndian.Uint32(data[0:]))
+	return nil
+}
+
+// DecodeFrom decode DriveThroughPenaltyServed from its wire format, returning the number of bytes read
+func (p *DriveThroughPenaltyServed) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, fmt.Errorf("unable to decode DriveThroughPenaltyServed: need 1 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// PacketSize size of DriveThroughPenaltyServed on the wire in bytes
+func (p *DriveThroughPenaltyServed) PacketSize() int {
+	return 1
+}
+
+// decode decode DriveThroughPenaltyServed from data holding at least 1 bytes
+func (p *DriveThroughPenaltyServed) decode(data []byte) error {
+	p.VehicleIdx = uint8(data[0])
+	return nil
+}
+
+// DecodeFrom decode FastestLap from its wire format, returning the number of bytes read
+func (p *FastestLap) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 5 {
+		return 0, fmt.Errorf("unable to decode FastestLap: need 5 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 5, nil
+}
+
+// PacketSize size of FastestLap on the wire in bytes
+func (p *FastestLap) PacketSize() int {
+	return 5
+}
+
+// decode decode FastestLap from data holding at least 5 bytes
+func (p *FastestLap) decode(data []byte) error {
+	p.VehicleIdx = uint8(data[0])
+	p.LapTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[1:])))
+	return nil
+}
+
+// DecodeFrom decode Flashback from its wire format, returning the number of bytes read
+func (p *Flashback) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 8 {
+		return 0, fmt.Errorf("unable to decode Flashback: need 8 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 8, nil
+}
+
+// PacketSize size of Flashback on the wire in bytes
+func (p *Flashback) PacketSize() int {
+	return 8
+}
+
+// decode decode Flashback from data holding at least 8 bytes
+func (p *Flashback) decode(data []byte) error {
+	p.FlashbackFrameIdentifier = uint32(binary.LittleEndian.Uint32(data[0:]))
+	p.FlashbackSessionTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[4:])))
+	return nil
+}
+
+// DecodeFrom decode Penalty from its wire format, returning the number of bytes read
+func (p *Penalty) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 7 {
+		return 0, fmt.Errorf("unable to decode Penalty: need 7 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 7, nil
+}
+
+// PacketSize size of Penalty on the wire in bytes
+func (p *Penalty) PacketSize() int {
+	return 7
+}
+
+// decode decode Penalty from data holding at least 7 bytes
+func (p *Penalty) decode(data []byte) error {
+	p.PenaltyType = PenaltyType(data[0])
+	p.InfringementType = InfringementType(data[1])
+	p.VehicleIdx = uint8(data[2])
+	p.OtherVehicleIdx = uint8(data[3])
+	p.Time = uint8(data[4])
+	p.LapNum = uint8(data[5])
+	p.PlacesGained = uint8(data[6])
+	return nil
+}
+
+// DecodeFrom decode RaceWinner from its wire format, returning the number of bytes read
+func (p *RaceWinner) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, fmt.Errorf("unable to decode RaceWinner: need 1 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// PacketSize size of RaceWinner on the wire in bytes
+func (p *RaceWinner) PacketSize() int {
+	return 1
+}
+
+// decode decode RaceWinner from data holding at least 1 bytes
+func (p *RaceWinner) decode(data []byte) error {
+	p.VehicleIdx = uint8(data[0])
+	return nil
+}
+
+// DecodeFrom decode Retirement from its wire format, returning the number of bytes read
+func (p *Retirement) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, fmt.Errorf("unable to decode Retirement: need 1 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// PacketSize size of Retirement on the wire in bytes
+func (p *Retirement) PacketSize() int {
+	return 1
+}
+
+// decode decode Retirement from data holding at least 1 bytes
+func (p *Retirement) decode(data []byte) error {
+	p.VehicleIdx = uint8(data[0])
+	return nil
+}
+
+// DecodeFrom decode SpeedTrap from its wire format, returning the number of bytes read
+func (p *SpeedTrap) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 5 {
+		return 0, fmt.Errorf("unable to decode SpeedTrap: need 5 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 5, nil
+}
+
+// PacketSize size of SpeedTrap on the wire in bytes
+func (p *SpeedTrap) PacketSize() int {
+	return 5
+}
+
+// decode decode SpeedTrap from data holding at least 5 bytes
+func (p *SpeedTrap) decode(data []byte) error {
+	p.VehicleIdx = uint8(data[0])
+	p.Speed = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[1:])))
+	return nil
+}
+
+// DecodeFrom decode SpeedTrap2021 from its wire format, returning the number of bytes read
+func (p *SpeedTrap2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 7 {
+		return 0, fmt.Errorf("unable to decode SpeedTrap2021: need 7 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 7, nil
+}
+
+// PacketSize size of SpeedTrap2021 on the wire in bytes
+func (p *SpeedTrap2021) PacketSize() int {
+	return 7
+}
+
+// decode decode SpeedTrap2021 from data holding at least 7 bytes
+func (p *SpeedTrap2021) decode(data []byte) error {
+	p.VehicleIdx = uint8(data[0])
+	p.Speed = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[1:])))
+	if data[5] > 1 {
+		return fmt.Errorf("failed to set OverallFastestInSession value: unexpected byte value %v is <0 or >1", data[5])
+	}
+	p.OverallFastestInSession = bool(data[5] == 1)
+	if data[6] > 1 {
+		return fmt.Errorf("failed to set DriverFastestInSession value: unexpected byte value %v is <0 or >1", data[6])
+	}
+	p.DriverFastestInSession = bool(data[6] == 1)
+	return nil
+}
+
+// DecodeFrom decode SpeedTrap2022 from its wire format, returning the number of bytes read
+func (p *SpeedTrap2022) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 12 {
+		return 0, fmt.Errorf("unable to decode SpeedTrap2022: need 12 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 12, nil
+}
+
+// PacketSize size of SpeedTrap2022 on the wire in bytes
+func (p *SpeedTrap2022) PacketSize() int {
+	return 12
+}
+
+// decode decode SpeedTrap2022 from data holding at least 12 bytes
+func (p *SpeedTrap2022) decode(data []byte) error {
+	p.VehicleIdx = uint8(data[0])
+	p.Speed = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[1:])))
+	if data[5] > 1 {
+		return fmt.Errorf("failed to set OverallFastestInSession value: unexpected byte value %v is <0 or >1", data[5])
+	}
+	p.OverallFastestInSession = bool(data[5] == 1)
+	if data[6] > 1 {
+		return fmt.Errorf("failed to set DriverFastestInSession value: unexpected byte value %v is <0 or >1", data[6])
+	}
+	p.DriverFastestInSession = bool(data[6] == 1)
+	p.FastestVehicleIdxInSession = uint8(data[7])
+	p.FastestSpeedInSession = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[8:])))
+	return nil
+}
+
+// DecodeFrom decode StartLights from its wire format, returning the number of bytes read
+func (p *StartLights) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, fmt.Errorf("unable to decode StartLights: need 1 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// PacketSize size of StartLights on the wire in bytes
+func (p *StartLights) PacketSize() int {
+	return 1
+}
+
+// decode decode StartLights from data holding at least 1 bytes
+func (p *StartLights) decode(data []byte) error {
+	p.NumLights = uint8(data[0])
+	return nil
+}
+
+// DecodeFrom decode StopGoPenaltyServed from its wire format, returning the number of bytes read
+func (p *StopGoPenaltyServed) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, fmt.Errorf("unable to decode StopGoPenaltyServed: need 1 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// PacketSize size of StopGoPenaltyServed on the wire in bytes
+func (p *StopGoPenaltyServed) PacketSize() int {
+	return 1
+}
+
+// decode decode StopGoPenaltyServed from data holding at least 1 bytes
+func (p *StopGoPenaltyServed) decode(data []byte) error {
+	p.VehicleIdx = uint8(data[0])
+	return nil
+}
+
+// DecodeFrom decode TeamMateInPits from its wire format, returning the number of bytes read
+func (p *TeamMateInPits) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, fmt.Errorf("unable to decode TeamMateInPits: need 1 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// PacketSize size of TeamMateInPits on the wire in bytes
+func (p *TeamMateInPits) PacketSize() int {
+	return 1
+}
+
+// decode decode TeamMateInPits from data holding at least 1 bytes
+func (p *TeamMateInPits) decode(data []byte) error {
+	p.VehicleIdx = uint8(data[0])
+	return nil
+}
@@ -0,0 +1,195 @@
+package event
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/enum-gen
+
+// EventCode four character code identifying the type of event
+type EventCode string
+
+const (
+	// EventCodeSessionStarted sent when the session starts
+	EventCodeSessionStarted EventCode = "SSTA"
+	// EventCodeSessionEnded sent when the session ends
+	EventCodeSessionEnded EventCode = "SEND"
+	// EventCodeFastestLap when a driver achieves the fastest lap
+	EventCodeFastestLap EventCode = "FTLP"
+	// EventCodeRetirement when a driver retires
+	EventCodeRetirement EventCode = "RTMT"
+	// EventCodeDRSEnabled race control have enabled DRS
+	EventCodeDRSEnabled EventCode = "DRSE"
+	// EventCodeDRSDisabled race control have disabled DRS
+	EventCodeDRSDisabled EventCode = "DRSD"
+	// EventCodeTeamMateInPits the player's team mate has entered the pits
+	EventCodeTeamMateInPits EventCode = "TMPT"
+	// EventCodeChequeredFlag the chequered flag has been waved
+	EventCodeChequeredFlag EventCode = "CHQF"
+	// EventCodeRaceWinner the race winner is announced
+	EventCodeRaceWinner EventCode = "RCWN"
+	// EventCodePenalty a penalty has been issued
+	EventCodePenalty EventCode = "PENA"
+	// EventCodeSpeedTrap speed trap has been triggered by the fastest speed
+	EventCodeSpeedTrap EventCode = "SPTP"
+	// EventCodeStartLights start lights - number shown - F1 2021 onwards
+	EventCodeStartLights EventCode = "STLG"
+	// EventCodeLightsOut lights out - F1 2021 onwards
+	EventCodeLightsOut EventCode = "LGOT"
+	// EventCodeDriveThroughServed drive through penalty served - F1 2021 onwards
+	EventCodeDriveThroughServed EventCode = "DTSV"
+	// EventCodeStopGoServed stop go penalty served - F1 2021 onwards
+	EventCodeStopGoServed EventCode = "SGSV"
+	// EventCodeFlashback flashback activated - F1 2021 onwards
+	EventCodeFlashback EventCode = "FLBK"
+	// EventCodeButtonStatus button status changed - F1 2021 onwards
+	EventCodeButtonStatus EventCode = "BUTN"
+)
+
+// PenaltyType type of penalty issued
+type PenaltyType uint8
+
+const (
+	// PenaltyTypeDriveThrough drive through
+	PenaltyTypeDriveThrough PenaltyType = 0
+	// PenaltyTypeStopGo stop go
+	PenaltyTypeStopGo PenaltyType = 1
+	// PenaltyTypeGridPenalty grid penalty
+	PenaltyTypeGridPenalty PenaltyType = 2
+	// PenaltyTypePenaltyReminder penalty reminder
+	PenaltyTypePenaltyReminder PenaltyType = 3
+	// PenaltyTypeTimePenalty time penalty
+	PenaltyTypeTimePenalty PenaltyType = 4
+	// PenaltyTypeWarning warning
+	PenaltyTypeWarning PenaltyType = 5
+	// PenaltyTypeDisqualified disqualified
+	PenaltyTypeDisqualified PenaltyType = 6
+	// PenaltyTypeRemovedFromFormationLap removed from formation lap
+	PenaltyTypeRemovedFromFormationLap PenaltyType = 7
+	// PenaltyTypeParkedTooLongTimer parked too long timer
+	PenaltyTypeParkedTooLongTimer PenaltyType = 8
+	// PenaltyTypeTyreRegulations tyre regulations
+	PenaltyTypeTyreRegulations PenaltyType = 9
+	// PenaltyTypeThisLapInvalidated this lap invalidated
+	PenaltyTypeThisLapInvalidated PenaltyType = 10
+	// PenaltyTypeThisAndNextLapInvalidated this and next lap invalidated
+	PenaltyTypeThisAndNextLapInvalidated PenaltyType = 11
+	// PenaltyTypeThisLapInvalidatedWithoutReason this lap invalidated without reason
+	PenaltyTypeThisLapInvalidatedWithoutReason PenaltyType = 12
+	// PenaltyTypeThisAndNextLapInvalidatedWithoutReason this and next lap invalidated without reason
+	PenaltyTypeThisAndNextLapInvalidatedWithoutReason PenaltyType = 13
+	// PenaltyTypeThisAndPreviousLapInvalidated this and previous lap invalidated
+	PenaltyTypeThisAndPreviousLapInvalidated PenaltyType = 14
+	// PenaltyTypeThisAndPreviousLapInvalidatedWithoutReason this and previous lap invalidated without reason
+	PenaltyTypeThisAndPreviousLapInvalidatedWithoutReason PenaltyType = 15
+	// PenaltyTypeRetired retired
+	PenaltyTypeRetired PenaltyType = 16
+	// PenaltyTypeBlackFlagTimer black flag timer
+	PenaltyTypeBlackFlagTimer PenaltyType = 17
+)
+
+// InfringementType type of infringement a penalty was issued for
+type InfringementType uint8
+
+const (
+	// InfringementTypeBlockingBySlowDriving blocking by slow driving
+	InfringementTypeBlockingBySlowDriving InfringementType = 0
+	// InfringementTypeBlockingByWrongWayDriving blocking by wrong way driving
+	InfringementTypeBlockingByWrongWayDriving InfringementType = 1
+	// InfringementTypeReversingOffTheStartLine reversing off the start line
+	InfringementTypeReversingOffTheStartLine InfringementType = 2
+	// InfringementTypeBigCollision big collision
+	InfringementTypeBigCollision InfringementType = 3
+	// InfringementTypeSmallCollision small collision
+	InfringementTypeSmallCollision InfringementType = 4
+	// InfringementTypeCollisionFailedToHandBackPositionSingle collision failed to hand back position single
+	InfringementTypeCollisionFailedToHandBackPositionSingle InfringementType = 5
+	// InfringementTypeCollisionFailedToHandBackPositionMultiple collision failed to hand back position multiple
+	InfringementTypeCollisionFailedToHandBackPositionMultiple InfringementType = 6
+	// InfringementTypeCornerCuttingGainedTime corner cutting gained time
+	InfringementTypeCornerCuttingGainedTime InfringementType = 7
+	// InfringementTypeCornerCuttingOvertakeSingle corner cutting overtake single
+	InfringementTypeCornerCuttingOvertakeSingle InfringementType = 8
+	// InfringementTypeCornerCuttingOvertakeMultiple corner cutting overtake multiple
+	InfringementTypeCornerCuttingOvertakeMultiple InfringementType = 9
+	// InfringementTypeCrossedPitExitLane crossed pit exit lane
+	InfringementTypeCrossedPitExitLane InfringementType = 10
+	// InfringementTypeIgnoringBlueFlags ignoring blue flags
+	InfringementTypeIgnoringBlueFlags InfringementType = 11
+	// InfringementTypeIgnoringYellowFlags ignoring yellow flags
+	InfringementTypeIgnoringYellowFlags InfringementType = 12
+	// InfringementTypeIgnoringDriveThrough ignoring drive through
+	InfringementTypeIgnoringDriveThrough InfringementType = 13
+	// InfringementTypeTooManyDriveThroughs too many drive throughs
+	InfringementTypeTooManyDriveThroughs InfringementType = 14
+	// InfringementTypeDriveThroughReminderServeWithinNLaps drive through reminder serve within n laps
+	InfringementTypeDriveThroughReminderServeWithinNLaps InfringementType = 15
+	// InfringementTypeDriveThroughReminderServeThisLap drive through reminder serve this lap
+	InfringementTypeDriveThroughReminderServeThisLap InfringementType = 16
+	// InfringementTypePitLaneSpeeding pit lane speeding
+	InfringementTypePitLaneSpeeding InfringementType = 17
+	// InfringementTypeParkedForTooLong parked for too long
+	InfringementTypeParkedForTooLong InfringementType = 18
+	// InfringementTypeIgnoringTyreRegulations ignoring tyre regulations
+	InfringementTypeIgnoringTyreRegulations InfringementType = 19
+	// InfringementTypeTooManyPenalties too many penalties
+	InfringementTypeTooManyPenalties InfringementType = 20
+	// InfringementTypeMultipleWarnings multiple warnings
+	InfringementTypeMultipleWarnings InfringementType = 21
+	// InfringementTypeApproachingDisqualification approaching disqualification
+	InfringementTypeApproachingDisqualification InfringementType = 22
+	// InfringementTypeTyreRegulationsSelectSingle tyre regulations select single
+	InfringementTypeTyreRegulationsSelectSingle InfringementType = 23
+	// InfringementTypeTyreRegulationsSelectMultiple tyre regulations select multiple
+	InfringementTypeTyreRegulationsSelectMultiple InfringementType = 24
+	// InfringementTypeLapInvalidatedCornerCutting lap invalidated corner cutting
+	InfringementTypeLapInvalidatedCornerCutting InfringementType = 25
+	// InfringementTypeLapInvalidatedRunningWide lap invalidated running wide
+	InfringementTypeLapInvalidatedRunningWide InfringementType = 26
+	// InfringementTypeCornerCuttingRanWideGainedTimeMinor corner cutting ran wide gained time minor
+	InfringementTypeCornerCuttingRanWideGainedTimeMinor InfringementType = 27
+	// InfringementTypeCornerCuttingRanWideGainedTimeSignificant corner cutting ran wide gained time significant
+	InfringementTypeCornerCuttingRanWideGainedTimeSignificant InfringementType = 28
+	// InfringementTypeCornerCuttingRanWideGainedTimeExtreme corner cutting ran wide gained time extreme
+	InfringementTypeCornerCuttingRanWideGainedTimeExtreme InfringementType = 29
+	// InfringementTypeLapInvalidatedWallRiding lap invalidated wall riding
+	InfringementTypeLapInvalidatedWallRiding InfringementType = 30
+	// InfringementTypeLapInvalidatedFlashbackUsed lap invalidated flashback used
+	InfringementTypeLapInvalidatedFlashbackUsed InfringementType = 31
+	// InfringementTypeLapInvalidatedResetToTrack lap invalidated reset to track
+	InfringementTypeLapInvalidatedResetToTrack InfringementType = 32
+	// InfringementTypeBlockingThePitlane blocking the pitlane
+	InfringementTypeBlockingThePitlane InfringementType = 33
+	// InfringementTypeJumpStart jump start
+	InfringementTypeJumpStart InfringementType = 34
+	// InfringementTypeSafetyCarToCarCollision safety car to car collision
+	InfringementTypeSafetyCarToCarCollision InfringementType = 35
+	// InfringementTypeSafetyCarIllegalOvertake safety car illegal overtake
+	InfringementTypeSafetyCarIllegalOvertake InfringementType = 36
+	// InfringementTypeSafetyCarExceedingAllowedPace safety car exceeding allowed pace
+	InfringementTypeSafetyCarExceedingAllowedPace InfringementType = 37
+	// InfringementTypeVirtualSafetyCarExceedingAllowedPace virtual safety car exceeding allowed pace
+	InfringementTypeVirtualSafetyCarExceedingAllowedPace InfringementType = 38
+	// InfringementTypeFormationLapBelowAllowedSpeed formation lap below allowed speed
+	InfringementTypeFormationLapBelowAllowedSpeed InfringementType = 39
+	// InfringementTypeRetiredMechanicalFailure retired mechanical failure
+	InfringementTypeRetiredMechanicalFailure InfringementType = 40
+	// InfringementTypeRetiredTerminallyDamaged retired terminally damaged
+	InfringementTypeRetiredTerminallyDamaged InfringementType = 41
+	// InfringementTypeSafetyCarFallingTooFarBack safety car falling too far back
+	InfringementTypeSafetyCarFallingTooFarBack InfringementType = 42
+	// InfringementTypeBlackFlagTimer black flag timer
+	InfringementTypeBlackFlagTimer InfringementType = 43
+	// InfringementTypeUnservedStopGoPenalty unserved stop go penalty
+	InfringementTypeUnservedStopGoPenalty InfringementType = 44
+	// InfringementTypeUnservedDriveThroughPenalty unserved drive through penalty
+	InfringementTypeUnservedDriveThroughPenalty InfringementType = 45
+	// InfringementTypeEngineComponentChange engine component change
+	InfringementTypeEngineComponentChange InfringementType = 46
+	// InfringementTypeGearboxChange gearbox change
+	InfringementTypeGearboxChange InfringementType = 47
+	// InfringementTypeLeagueGridPenalty league grid penalty
+	InfringementTypeLeagueGridPenalty InfringementType = 48
+	// InfringementTypeRetryPenalty retry penalty
+	InfringementTypeRetryPenalty InfringementType = 49
+	// InfringementTypeIllegalTimeGain illegal time gain
+	InfringementTypeIllegalTimeGain InfringementType = 50
+	// InfringementTypeMandatoryPitstop mandatory pitstop
+	InfringementTypeMandatoryPitstop InfringementType = 51
+)
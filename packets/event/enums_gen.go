@@ -0,0 +1,255 @@
+// Code generated by enum-gen. DO NOT EDIT.
+
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var penaltyTypeNames = map[PenaltyType]string{
+	0:  "drive_through",
+	1:  "stop_go",
+	2:  "grid_penalty",
+	3:  "penalty_reminder",
+	4:  "time_penalty",
+	5:  "warning",
+	6:  "disqualified",
+	7:  "removed_from_formation_lap",
+	8:  "parked_too_long_timer",
+	9:  "tyre_regulations",
+	10: "this_lap_invalidated",
+	11: "this_and_next_lap_invalidated",
+	12: "this_lap_invalidated_without_reason",
+	13: "this_and_next_lap_invalidated_without_reason",
+	14: "this_and_previous_lap_invalidated",
+	15: "this_and_previous_lap_invalidated_without_reason",
+	16: "retired",
+	17: "black_flag_timer",
+}
+
+var penaltyTypeValues = map[string]PenaltyType{
+	"drive_through":                       0,
+	"stop_go":                             1,
+	"grid_penalty":                        2,
+	"penalty_reminder":                    3,
+	"time_penalty":                        4,
+	"warning":                             5,
+	"disqualified":                        6,
+	"removed_from_formation_lap":          7,
+	"parked_too_long_timer":               8,
+	"tyre_regulations":                    9,
+	"this_lap_invalidated":                10,
+	"this_and_next_lap_invalidated":       11,
+	"this_lap_invalidated_without_reason": 12,
+	"this_and_next_lap_invalidated_without_reason":     13,
+	"this_and_previous_lap_invalidated":                14,
+	"this_and_previous_lap_invalidated_without_reason": 15,
+	"retired":          16,
+	"black_flag_timer": 17,
+}
+
+// String name of the PenaltyType, or the type and number for unknown values
+func (v PenaltyType) String() string {
+	if name, ok := penaltyTypeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("PenaltyType(%d)", v)
+}
+
+// MarshalText name of the PenaltyType, or the number for unknown values
+func (v PenaltyType) MarshalText() ([]byte, error) {
+	if name, ok := penaltyTypeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the PenaltyType from its name or number
+func (v *PenaltyType) UnmarshalText(text []byte) error {
+	if val, ok := penaltyTypeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown PenaltyType %q", text)
+	}
+	*v = PenaltyType(n)
+	return nil
+}
+
+// MarshalJSON name of the PenaltyType as a string, or its number for unknown values
+func (v PenaltyType) MarshalJSON() ([]byte, error) {
+	if name, ok := penaltyTypeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the PenaltyType from its name as a string, or its number
+func (v *PenaltyType) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var infringementTypeNames = map[InfringementType]string{
+	0:  "blocking_by_slow_driving",
+	1:  "blocking_by_wrong_way_driving",
+	2:  "reversing_off_the_start_line",
+	3:  "big_collision",
+	4:  "small_collision",
+	5:  "collision_failed_to_hand_back_position_single",
+	6:  "collision_failed_to_hand_back_position_multiple",
+	7:  "corner_cutting_gained_time",
+	8:  "corner_cutting_overtake_single",
+	9:  "corner_cutting_overtake_multiple",
+	10: "crossed_pit_exit_lane",
+	11: "ignoring_blue_flags",
+	12: "ignoring_yellow_flags",
+	13: "ignoring_drive_through",
+	14: "too_many_drive_throughs",
+	15: "drive_through_reminder_serve_within_n_laps",
+	16: "drive_through_reminder_serve_this_lap",
+	17: "pit_lane_speeding",
+	18: "parked_for_too_long",
+	19: "ignoring_tyre_regulations",
+	20: "too_many_penalties",
+	21: "multiple_warnings",
+	22: "approaching_disqualification",
+	23: "tyre_regulations_select_single",
+	24: "tyre_regulations_select_multiple",
+	25: "lap_invalidated_corner_cutting",
+	26: "lap_invalidated_running_wide",
+	27: "corner_cutting_ran_wide_gained_time_minor",
+	28: "corner_cutting_ran_wide_gained_time_significant",
+	29: "corner_cutting_ran_wide_gained_time_extreme",
+	30: "lap_invalidated_wall_riding",
+	31: "lap_invalidated_flashback_used",
+	32: "lap_invalidated_reset_to_track",
+	33: "blocking_the_pitlane",
+	34: "jump_start",
+	35: "safety_car_to_car_collision",
+	36: "safety_car_illegal_overtake",
+	37: "safety_car_exceeding_allowed_pace",
+	38: "virtual_safety_car_exceeding_allowed_pace",
+	39: "formation_lap_below_allowed_speed",
+	40: "retired_mechanical_failure",
+	41: "retired_terminally_damaged",
+	42: "safety_car_falling_too_far_back",
+	43: "black_flag_timer",
+	44: "unserved_stop_go_penalty",
+	45: "unserved_drive_through_penalty",
+	46: "engine_component_change",
+	47: "gearbox_change",
+	48: "league_grid_penalty",
+	49: "retry_penalty",
+	50: "illegal_time_gain",
+	51: "mandatory_pitstop",
+}
+
+var infringementTypeValues = map[string]InfringementType{
+	"blocking_by_slow_driving":                        0,
+	"blocking_by_wrong_way_driving":                   1,
+	"reversing_off_the_start_line":                    2,
+	"big_collision":                                   3,
+	"small_collision":                                 4,
+	"collision_failed_to_hand_back_position_single":   5,
+	"collision_failed_to_hand_back_position_multiple": 6,
+	"corner_cutting_gained_time":                      7,
+	"corner_cutting_overtake_single":                  8,
+	"corner_cutting_overtake_multiple":                9,
+	"crossed_pit_exit_lane":                           10,
+	"ignoring_blue_flags":                             11,
+	"ignoring_yellow_flags":                           12,
+	"ignoring_drive_through":                          13,
+	"too_many_drive_throughs":                         14,
+	"drive_through_reminder_serve_within_n_laps":      15,
+	"drive_through_reminder_serve_this_lap":           16,
+	"pit_lane_speeding":                               17,
+	"parked_for_too_long":                             18,
+	"ignoring_tyre_regulations":                       19,
+	"too_many_penalties":                              20,
+	"multiple_warnings":                               21,
+	"approaching_disqualification":                    22,
+	"tyre_regulations_select_single":                  23,
+	"tyre_regulations_select_multiple":                24,
+	"lap_invalidated_corner_cutting":                  25,
+	"lap_invalidated_running_wide":                    26,
+	"corner_cutting_ran_wide_gained_time_minor":       27,
+	"corner_cutting_ran_wide_gained_time_significant": 28,
+	"corner_cutting_ran_wide_gained_time_extreme":     29,
+	"lap_invalidated_wall_riding":                     30,
+	"lap_invalidated_flashback_used":                  31,
+	"lap_invalidated_reset_to_track":                  32,
+	"blocking_the_pitlane":                            33,
+	"jump_start":                                      34,
+	"safety_car_to_car_collision":                     35,
+	"safety_car_illegal_overtake":                     36,
+	"safety_car_exceeding_allowed_pace":               37,
+	"virtual_safety_car_exceeding_allowed_pace":       38,
+	"formation_lap_below_allowed_speed":               39,
+	"retired_mechanical_failure":                      40,
+	"retired_terminally_damaged":                      41,
+	"safety_car_falling_too_far_back":                 42,
+	"black_flag_timer":                                43,
+	"unserved_stop_go_penalty":                        44,
+	"unserved_drive_through_penalty":                  45,
+	"engine_component_change":                         46,
+	"gearbox_change":                                  47,
+	"league_grid_penalty":                             48,
+	"retry_penalty":                                   49,
+	"illegal_time_gain":                               50,
+	"mandatory_pitstop":                               51,
+}
+
+// String name of the InfringementType, or the type and number for unknown values
+func (v InfringementType) String() string {
+	if name, ok := infringementTypeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("InfringementType(%d)", v)
+}
+
+// MarshalText name of the InfringementType, or the number for unknown values
+func (v InfringementType) MarshalText() ([]byte, error) {
+	if name, ok := infringementTypeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the InfringementType from its name or number
+func (v *InfringementType) UnmarshalText(text []byte) error {
+	if val, ok := infringementTypeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown InfringementType %q", text)
+	}
+	*v = InfringementType(n)
+	return nil
+}
+
+// MarshalJSON name of the InfringementType as a string, or its number for unknown values
+func (v InfringementType) MarshalJSON() ([]byte, error) {
+	if name, ok := infringementTypeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the InfringementType from its name as a string, or its number
+func (v *InfringementType) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
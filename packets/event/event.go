@@ -0,0 +1,253 @@
+package event
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
+import (
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets"
+	"github.com/roryphillips/f1-telemetry-client/packets/car_telemetry"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet event data
+type Packet struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// EventCode type of event which occurred
+	EventCode EventCode `json:"event_code"`
+	// Details event specific details, nil for events which carry none
+	// i.e. *FastestLap for EventCodeFastestLap
+	// the layout of some details depends on the packet format, i.e. *SpeedTrap2021 for F1 2021
+	Details interface{} `json:"details,omitempty"`
+}
+
+// FastestLap details of a fastest lap event
+type FastestLap struct {
+	// VehicleIdx index of the car achieving the fastest lap
+	VehicleIdx uint8 `json:"vehicle_idx" packet:"0"`
+	// LapTime lap time in seconds
+	LapTime float32 `json:"lap_time" packet:"1"`
+}
+
+// Retirement details of a retirement event
+type Retirement struct {
+	// VehicleIdx index of the car retiring
+	VehicleIdx uint8 `json:"vehicle_idx" packet:"0"`
+}
+
+// TeamMateInPits details of a team mate in pits event
+type TeamMateInPits struct {
+	// VehicleIdx index of the team mate's car
+	VehicleIdx uint8 `json:"vehicle_idx" packet:"0"`
+}
+
+// RaceWinner details of a race winner event
+type RaceWinner struct {
+	// VehicleIdx index of the race winner's car
+	VehicleIdx uint8 `json:"vehicle_idx" packet:"0"`
+}
+
+// Penalty details of a penalty event
+type Penalty struct {
+	// PenaltyType type of penalty issued
+	PenaltyType PenaltyType `json:"penalty_type" packet:"0"`
+	// InfringementType type of infringement committed
+	InfringementType InfringementType `json:"infringement_type" packet:"1"`
+	// VehicleIdx index of the car the penalty is applied to
+	VehicleIdx uint8 `json:"vehicle_idx" packet:"2"`
+	// OtherVehicleIdx index of the other car involved
+	OtherVehicleIdx uint8 `json:"other_vehicle_idx" packet:"3"`
+	// Time time gained or spent doing the action in seconds
+	Time uint8 `json:"time" packet:"4"`
+	// LapNum lap number the penalty occurred on
+	LapNum uint8 `json:"lap_num" packet:"5"`
+	// PlacesGained number of places gained by the infringement
+	PlacesGained uint8 `json:"places_gained" packet:"6"`
+}
+
+// SpeedTrap details of a speed trap event
+type SpeedTrap struct {
+	// VehicleIdx index of the car triggering the speed trap
+	VehicleIdx uint8 `json:"vehicle_idx" packet:"0"`
+	// Speed top speed achieved in km/h
+	Speed float32 `json:"speed" packet:"1"`
+}
+
+// SpeedTrap2021 details of an F1 2021 speed trap event
+type SpeedTrap2021 struct {
+	// VehicleIdx index of the car triggering the speed trap
+	VehicleIdx uint8 `json:"vehicle_idx" packet:"0"`
+	// Speed top speed achieved in km/h
+	Speed float32 `json:"speed" packet:"1"`
+	// OverallFastestInSession whether this is the fastest speed in the session
+	OverallFastestInSession bool `json:"overall_fastest_in_session" packet:"2"`
+	// DriverFastestInSession whether this is the driver's fastest speed in the session
+	DriverFastestInSession bool `json:"driver_fastest_in_session" packet:"3"`
+}
+
+// SpeedTrap2022 details of an F1 2022 speed trap event
+type SpeedTrap2022 struct {
+	// VehicleIdx index of the car triggering the speed trap
+	VehicleIdx uint8 `json:"vehicle_idx" packet:"0"`
+	// Speed top speed achieved in km/h
+	Speed float32 `json:"speed" packet:"1"`
+	// OverallFastestInSession whether this is the fastest speed in the session
+	OverallFastestInSession bool `json:"overall_fastest_in_session" packet:"2"`
+	// DriverFastestInSession whether this is the driver's fastest speed in the session
+	DriverFastestInSession bool `json:"driver_fastest_in_session" packet:"3"`
+	// FastestVehicleIdxInSession index of the car with the fastest speed in the session
+	FastestVehicleIdxInSession uint8 `json:"fastest_vehicle_idx_in_session" packet:"4"`
+	// FastestSpeedInSession fastest speed in the session in km/h
+	FastestSpeedInSession float32 `json:"fastest_speed_in_session" packet:"5"`
+}
+
+// StartLights details of a start lights event
+type StartLights struct {
+	// NumLights number of lights showing
+	NumLights uint8 `json:"num_lights" packet:"0"`
+}
+
+// DriveThroughPenaltyServed details of a drive through served event
+type DriveThroughPenaltyServed struct {
+	// VehicleIdx index of the car serving the drive through
+	VehicleIdx uint8 `json:"vehicle_idx" packet:"0"`
+}
+
+// StopGoPenaltyServed details of a stop go served event
+type StopGoPenaltyServed struct {
+	// VehicleIdx index of the car serving the stop go
+	VehicleIdx uint8 `json:"vehicle_idx" packet:"0"`
+}
+
+// Flashback details of a flashback event
+type Flashback struct {
+	// FlashbackFrameIdentifier frame identifier flashed back to
+	FlashbackFrameIdentifier uint32 `json:"flashback_frame_identifier" packet:"0"`
+	// FlashbackSessionTime session time flashed back to
+	FlashbackSessionTime float32 `json:"flashback_session_time" packet:"1"`
+}
+
+// Buttons details of a button status event
+type Buttons struct {
+	// ButtonStatus buttons currently pressed
+	ButtonStatus car_telemetry.ButtonFlags `json:"button_status" packet:"0"`
+}
+
+// UnmarshalPacket read the event code and then the details for that event type
+func (p *Packet) UnmarshalPacket(parser packets.PacketParser, data packets.Packet) error {
+	code, err := data.Bytes(4)
+	if err != nil {
+		return fmt.Errorf("unable to read event code: %v", err)
+	}
+	p.EventCode = EventCode(code)
+
+	details := newDetails(p.Header.PacketFormat, p.EventCode)
+	if details == nil {
+		p.Details = nil
+		return nil
+	}
+
+	err = parser.Parse(data, details)
+	if err != nil {
+		return fmt.Errorf("unable to parse %v event details: %v", p.EventCode, err)
+	}
+	p.Details = details
+
+	return nil
+}
+
+// DecodeFrom decode the event code and then the details for that event type, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, fmt.Errorf("unable to decode event code: need 4 bytes, have %v", len(data))
+	}
+	p.EventCode = EventCode(data[:4])
+
+	details := newDetails(p.Header.PacketFormat, p.EventCode)
+	if details == nil {
+		p.Details = nil
+		return 4, nil
+	}
+
+	n, err := details.DecodeFrom(data[4:])
+	if err != nil {
+		return 0, fmt.Errorf("unable to decode %v event details: %v", p.EventCode, err)
+	}
+	p.Details = details
+
+	return 4 + n, nil
+}
+
+// MarshalPacket write the event code and then the details for that event type
+func (p *Packet) MarshalPacket(writer packets.PacketWriter) ([]byte, error) {
+	if len(p.EventCode) != 4 {
+		return nil, fmt.Errorf("event code %q must be 4 bytes", p.EventCode)
+	}
+	out := []byte(p.EventCode)
+	if p.Details != nil {
+		details, err := writer.Encode(p.Details)
+		if err != nil {
+			return nil, fmt.Errorf("unable to write %v event details: %v", p.EventCode, err)
+		}
+		out = append(out, details...)
+	}
+	// The details are a union, padded to the size of the largest event in the format
+	if size := p.PacketSize(); len(out) < size {
+		out = append(out, make([]byte, size-len(out))...)
+	}
+
+	return out, nil
+}
+
+// PacketSize size of the event on the wire in bytes, which depends on the packet format rather than the event code
+func (p *Packet) PacketSize() int {
+	return 4 + detailsSize(p.Header.PacketFormat)
+}
+
+// detailsSize size of the details union on the wire for a packet format
+func detailsSize(format uint16) int {
+	switch {
+	case format <= common.PacketFormat2019:
+		return 5
+	case format == common.PacketFormat2020:
+		return 7
+	case format == common.PacketFormat2021:
+		return 8
+	}
+	return 12
+}
+
+// newDetails create the details struct for an event code in a packet format, nil if the event has no details
+func newDetails(format uint16, code EventCode) packets.PacketDecoder {
+	switch code {
+	case EventCodeFastestLap:
+		return &FastestLap{}
+	case EventCodeRetirement:
+		return &Retirement{}
+	case EventCodeTeamMateInPits:
+		return &TeamMateInPits{}
+	case EventCodeRaceWinner:
+		return &RaceWinner{}
+	case EventCodePenalty:
+		return &Penalty{}
+	case EventCodeSpeedTrap:
+		switch {
+		case format >= common.PacketFormat2022:
+			return &SpeedTrap2022{}
+		case format == common.PacketFormat2021:
+			return &SpeedTrap2021{}
+		}
+		return &SpeedTrap{}
+	case EventCodeStartLights:
+		return &StartLights{}
+	case EventCodeDriveThroughServed:
+		return &DriveThroughPenaltyServed{}
+	case EventCodeStopGoServed:
+		return &StopGoPenaltyServed{}
+	case EventCodeFlashback:
+		return &Flashback{}
+	case EventCodeButtonStatus:
+		return &Buttons{}
+	}
+	return nil
+}
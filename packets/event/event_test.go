@@ -0,0 +1,127 @@
+package event_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/car_telemetry"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/event"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"reflect"
+	"testing"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name   string
+		format uint16
+		code   event.EventCode
+		// details append the event details following the code
+		details func(b *packettest.Builder)
+		want    interface{}
+	}{
+		{
+			name:    "session started has no details",
+			format:  common.PacketFormat2019,
+			code:    event.EventCodeSessionStarted,
+			details: func(b *packettest.Builder) {},
+		},
+		{
+			name:    "2019 fastest lap",
+			format:  common.PacketFormat2019,
+			code:    event.EventCodeFastestLap,
+			details: func(b *packettest.Builder) { b.U8(7).F32(81.25) },
+			want:    &event.FastestLap{VehicleIdx: 7, LapTime: 81.25},
+		},
+		{
+			name:    "2020 speed trap",
+			format:  common.PacketFormat2020,
+			code:    event.EventCodeSpeedTrap,
+			details: func(b *packettest.Builder) { b.U8(19).F32(331.5) },
+			want:    &event.SpeedTrap{VehicleIdx: 19, Speed: 331.5},
+		},
+		{
+			name:    "2021 speed trap",
+			format:  common.PacketFormat2021,
+			code:    event.EventCodeSpeedTrap,
+			details: func(b *packettest.Builder) { b.U8(19).F32(331.5).Bool(false).Bool(true) },
+			want:    &event.SpeedTrap2021{VehicleIdx: 19, Speed: 331.5, OverallFastestInSession: false, DriverFastestInSession: true},
+		},
+		{
+			name:    "2022 speed trap",
+			format:  common.PacketFormat2022,
+			code:    event.EventCodeSpeedTrap,
+			details: func(b *packettest.Builder) { b.U8(19).F32(331.5).Bool(true).Bool(false).U8(4).F32(340.25) },
+			want: &event.SpeedTrap2022{
+				VehicleIdx:                 19,
+				Speed:                      331.5,
+				OverallFastestInSession:    true,
+				DriverFastestInSession:     false,
+				FastestVehicleIdxInSession: 4,
+				FastestSpeedInSession:      340.25,
+			},
+		},
+		{
+			name:   "2020 penalty",
+			format: common.PacketFormat2020,
+			code:   event.EventCodePenalty,
+			details: func(b *packettest.Builder) {
+				b.U8(uint8(event.PenaltyTypeTimePenalty)).U8(uint8(event.InfringementTypeCornerCuttingGainedTime)).
+					U8(3).U8(255).U8(5).U8(12).U8(0)
+			},
+			want: &event.Penalty{
+				PenaltyType:      event.PenaltyTypeTimePenalty,
+				InfringementType: event.InfringementTypeCornerCuttingGainedTime,
+				VehicleIdx:       3,
+				OtherVehicleIdx:  255,
+				Time:             5,
+				LapNum:           12,
+				PlacesGained:     0,
+			},
+		},
+		{
+			name:    "2021 start lights",
+			format:  common.PacketFormat2021,
+			code:    event.EventCodeStartLights,
+			details: func(b *packettest.Builder) { b.U8(4) },
+			want:    &event.StartLights{NumLights: 4},
+		},
+		{
+			name:    "2022 flashback",
+			format:  common.PacketFormat2022,
+			code:    event.EventCodeFlashback,
+			details: func(b *packettest.Builder) { b.U32(123456).F32(654.5) },
+			want:    &event.Flashback{FlashbackFrameIdentifier: 123456, FlashbackSessionTime: 654.5},
+		},
+		{
+			name:   "2022 buttons",
+			format: common.PacketFormat2022,
+			code:   event.EventCodeButtonStatus,
+			details: func(b *packettest.Builder) {
+				b.U32(uint32(car_telemetry.ButtonCrossOrA | car_telemetry.ButtonSquareOrX))
+			},
+			want: &event.Buttons{ButtonStatus: car_telemetry.ButtonCrossOrA | car_telemetry.ButtonSquareOrX},
+		},
+	}
+
+	for _, test := range tests {
+		layout := packettest.LayoutOf(test.format, common.PacketIDEvent)
+		b := &packettest.Builder{}
+		b.String(string(test.code), 4)
+		test.details(b)
+		// Every event is padded to the size of the largest event's details
+		b.Zeros(layout.BodySize() - b.Len())
+
+		p := packettest.Golden(t, layout, b.Bytes()).(*event.Packet)
+		if p.EventCode != test.code {
+			t.Errorf("%v: event code %q, want %q", test.name, p.EventCode, test.code)
+		}
+		if test.want == nil {
+			if p.Details != nil {
+				t.Errorf("%v: details %+v, want none", test.name, p.Details)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(p.Details, test.want) {
+			t.Errorf("%v: details %+v, want %+v", test.name, p.Details, test.want)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package final_classification
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets/car_status"
+	"github.com/roryphillips/f1-telemetry-client/packets/lap_data"
+	"math"
+)
+
+// DecodeFrom decode FinalClassificationData from its wire format, returning the number of bytes read
+func (p *FinalClassificationData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 37 {
+		return 0, fmt.Errorf("unable to decode FinalClassificationData: need 37 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 37, nil
+}
+
+// PacketSize size of FinalClassificationData on the wire in bytes
+func (p *FinalClassificationData) PacketSize() int {
+	return 37
+}
+
+// decode decode FinalClassificationData from data holding at least 37 bytes
+func (p *FinalClassificationData) decode(data []byte) error {
+	p.Position = uint8(data[0])
+	p.NumLaps = uint8(data[1])
+	p.GridPosition = uint8(data[2])
+	p.Points = uint8(data[3])
+	p.NumPitStops = uint8(data[4])
+	p.ResultStatus = lap_data.ResultStatus(data[5])
+	p.BestLapTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[6:])))
+	p.TotalRaceTime = float64(math.Float64frombits(binary.LittleEndian.Uint64(data[10:])))
+	p.PenaltiesTime = uint8(data[18])
+	p.NumPenalties = uint8(data[19])
+	p.NumTyreStints = uint8(data[20])
+	for i0 := range p.TyreStintsActual {
+		p.TyreStintsActual[i0] = car_status.ActualTyreCompound(data[21+i0*1])
+	}
+	for i0 := range p.TyreStintsVisual {
+		p.TyreStintsVisual[i0] = car_status.VisualTyreCompound(data[29+i0*1])
+	}
+	return nil
+}
+
+// DecodeFrom decode FinalClassificationData2021 from its wire format, returning the number of bytes read
+func (p *FinalClassificationData2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 37 {
+		return 0, fmt.Errorf("unable to decode FinalClassificationData2021: need 37 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 37, nil
+}
+
+// PacketSize size of FinalClassificationData2021 on the wire in bytes
+func (p *FinalClassificationData2021) PacketSize() int {
+	return 37
+}
+
+// decode decode FinalClassificationData2021 from data holding at least 37 bytes
+func (p *FinalClassificationData2021) decode(data []byte) error {
+	p.Position = uint8(data[0])
+	p.NumLaps = uint8(data[1])
+	p.GridPosition = uint8(data[2])
+	p.Points = uint8(data[3])
+	p.NumPitStops = uint8(data[4])
+	p.ResultStatus = lap_data.ResultStatus(data[5])
+	p.BestLapTime = uint32(binary.LittleEndian.Uint32(data[6:]))
+	p.TotalRaceTime = float64(math.Float64frombits(binary.LittleEndian.Uint64(data[10:])))
+	p.PenaltiesTime = uint8(data[18])
+	p.NumPenalties = uint8(data[19])
+	p.NumTyreStints = uint8(data[20])
+	for i0 := range p.TyreStintsActual {
+		p.TyreStintsActual[i0] = car_status.ActualTyreCompound(data[21+i0*1])
+	}
+	for i0 := range p.TyreStintsVisual {
+		p.TyreStintsVisual[i0] = car_status.VisualTyreCompound(data[29+i0*1])
+	}
+	return nil
+}
+
+// DecodeFrom decode FinalClassificationData2022 from its wire format, returning the number of bytes read
+func (p *FinalClassificationData2022) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 45 {
+		return 0, fmt.Errorf("unable to decode FinalClassificationData2022: need 45 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 45, nil
+}
+
+// PacketSize size of FinalClassificationData2022 on the wire in bytes
+func (p *FinalClassificationData2022) PacketSize() int {
+	return 45
+}
+
+// decode decode FinalClassificationData2022 from data holding at least 45 bytes
+func (p *FinalClassificationData2022) decode(data []byte) error {
+	p.Position = uint8(data[0])
+	p.NumLaps = uint8(data[1])
+	p.GridPosition = uint8(data[2])
+	p.Points = uint8(data[3])
+	p.NumPitStops = uint8(data[4])
+	p.ResultStatus = lap_data.ResultStatus(data[5])
+	p.BestLapTime = uint32(binary.LittleEndian.Uint32(data[6:]))
+	p.TotalRaceTime = float64(math.Float64frombits(binary.LittleEndian.Uint64(data[10:])))
+	p.PenaltiesTime = uint8(data[18])
+	p.NumPenalties = uint8(data[19])
+	p.NumTyreStints = uint8(data[20])
+	for i0 := range p.TyreStintsActual {
+		p.TyreStintsActual[i0] = car_status.ActualTyreCompound(data[21+i0*1])
+	}
+	for i0 := range p.TyreStintsVisual {
+		p.TyreStintsVisual[i0] = car_status.VisualTyreCompound(data[29+i0*1])
+	}
+	for i0 := range p.TyreStintsEndLaps {
+		p.TyreStintsEndLaps[i0] = uint8(data[37+i0*1])
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 815 {
+		return 0, fmt.Errorf("unable to decode Packet: need 815 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 815, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 815
+}
+
+// decode decode Packet from data holding at least 815 bytes
+func (p *Packet) decode(data []byte) error {
+	p.NumCars = uint8(data[0])
+	for i0 := range p.ClassificationData {
+		if err := p.ClassificationData[i0].decode(data[1+i0*37:]); err != nil {
+			return fmt.Errorf("unable to set struct field ClassificationData item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2021 from its wire format, returning the number of bytes read
+func (p *Packet2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 815 {
+		return 0, fmt.Errorf("unable to decode Packet2021: need 815 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 815, nil
+}
+
+// PacketSize size of Packet2021 on the wire in bytes
+func (p *Packet2021) PacketSize() int {
+	return 815
+}
+
+// decode decode Packet2021 from data holding at least 815 bytes
+func (p *Packet2021) decode(data []byte) error {
+	p.NumCars = uint8(data[0])
+	for i0 := range p.ClassificationData {
+		if err := p.ClassificationData[i0].decode(data[1+i0*37:]); err != nil {
+			return fmt.Errorf("unable to set struct field ClassificationData item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2022 from its wire format, returning the number of bytes read
+func (p *Packet2022) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 991 {
+		return 0, fmt.Errorf("unable to decode Packet2022: need 991 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 991, nil
+}
+
+// PacketSize size of Packet2022 on the wire in bytes
+func (p *Packet2022) PacketSize() int {
+	return 991
+}
+
+// decode decode Packet2022 from data holding at least 991 bytes
+func (p *Packet2022) decode(data []byte) error {
+	p.NumCars = uint8(data[0])
+	for i0 := range p.ClassificationData {
+		if err := p.ClassificationData[i0].decode(data[1+i0*45:]); err != nil {
+			return fmt.Errorf("unable to set struct field ClassificationData item: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package final_classification
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/car_status"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/lap_data"
+)
+
+// Packet2021 F1 2021 final classification data
+type Packet2021 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// NumCars number of cars in the final classification
+	NumCars uint8 `json:"num_cars" packet:"0"`
+	// ClassificationData final classification for all cars
+	ClassificationData [22]FinalClassificationData2021 `json:"classification_data" packet:"1"`
+}
+
+// FinalClassificationData2021 F1 2021 per-car final classification data
+type FinalClassificationData2021 struct {
+	// Position finishing position
+	Position uint8 `json:"position" packet:"0"`
+	// NumLaps number of laps completed
+	NumLaps uint8 `json:"num_laps" packet:"1"`
+	// GridPosition grid position of the car
+	GridPosition uint8 `json:"grid_position" packet:"2"`
+	// Points number of points scored
+	Points uint8 `json:"points" packet:"3"`
+	// NumPitStops number of pit stops made
+	NumPitStops uint8 `json:"num_pit_stops" packet:"4"`
+	// ResultStatus result status of the car
+	ResultStatus lap_data.ResultStatus `json:"result_status" packet:"5"`
+	// BestLapTime best lap time of the session in milliseconds
+	BestLapTime uint32 `json:"best_lap_time" packet:"6"`
+	// TotalRaceTime total race time in seconds without penalties
+	TotalRaceTime float64 `json:"total_race_time" packet:"7"`
+	// PenaltiesTime total penalties accumulated in seconds
+	PenaltiesTime uint8 `json:"penalties_time" packet:"8"`
+	// NumPenalties number of penalties applied to this driver
+	NumPenalties uint8 `json:"num_penalties" packet:"9"`
+	// NumTyreStints number of tyre stints up to a maximum of 8
+	NumTyreStints uint8 `json:"num_tyre_stints" packet:"10"`
+	// TyreStintsActual actual tyres used by the driver
+	TyreStintsActual [8]car_status.ActualTyreCompound `json:"tyre_stints_actual" packet:"11"`
+	// TyreStintsVisual visual tyres used by the driver
+	TyreStintsVisual [8]car_status.VisualTyreCompound `json:"tyre_stints_visual" packet:"12"`
+}
@@ -0,0 +1,154 @@
+package final_classification_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/car_status"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/final_classification"
+	"github.com/roryphillips/f1-telemetry-client/packets/lap_data"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"testing"
+)
+
+// results append the fields up to BestLapTime of car i, which differs in type between formats
+func results(b *packettest.Builder, i int) {
+	b.U8(uint8(i + 1)).U8(58).U8(uint8(22 - i)).U8(uint8(25 - i)).U8(uint8(i % 3))
+	b.U8(uint8(lap_data.ResultStatusFinished))
+}
+
+// stints append the fields after BestLapTime of car i, without the 2022 stint end laps
+func stints(b *packettest.Builder, i int) {
+	b.F64(5400.125 + float64(i)).U8(uint8(i % 6)).U8(uint8(i % 2)).U8(3)
+	for s := 0; s < 8; s++ {
+		b.U8(uint8(car_status.ActualTyreCompoundC1) - uint8(s%5))
+	}
+	for s := 0; s < 8; s++ {
+		b.U8(uint8(car_status.VisualTyreCompoundSoft) + uint8(s%3))
+	}
+}
+
+// wantStints the tyre stints appended by stints
+func wantStints() ([8]car_status.ActualTyreCompound, [8]car_status.VisualTyreCompound) {
+	var actual [8]car_status.ActualTyreCompound
+	var visual [8]car_status.VisualTyreCompound
+	for s := range actual {
+		actual[s] = car_status.ActualTyreCompoundC1 - car_status.ActualTyreCompound(s%5)
+		visual[s] = car_status.VisualTyreCompoundSoft + car_status.VisualTyreCompound(s%3)
+	}
+	return actual, visual
+}
+
+func TestGolden2020(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2020, common.PacketIDFinalClassification)
+	b := &packettest.Builder{}
+	b.U8(20)
+	for i := 0; i < 22; i++ {
+		results(b, i)
+		b.F32(80.5 + float32(i))
+		stints(b, i)
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*final_classification.Packet)
+	if p.NumCars != 20 {
+		t.Errorf("%v: %v cars", layout.Name, p.NumCars)
+	}
+	actual, visual := wantStints()
+	for i, data := range p.ClassificationData {
+		want := final_classification.FinalClassificationData{
+			Position:         uint8(i + 1),
+			NumLaps:          58,
+			GridPosition:     uint8(22 - i),
+			Points:           uint8(25 - i),
+			NumPitStops:      uint8(i % 3),
+			ResultStatus:     lap_data.ResultStatusFinished,
+			BestLapTime:      80.5 + float32(i),
+			TotalRaceTime:    5400.125 + float64(i),
+			PenaltiesTime:    uint8(i % 6),
+			NumPenalties:     uint8(i % 2),
+			NumTyreStints:    3,
+			TyreStintsActual: actual,
+			TyreStintsVisual: visual,
+		}
+		if data != want {
+			t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, data, want)
+		}
+	}
+}
+
+func TestGolden2021(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2021, common.PacketIDFinalClassification)
+	b := &packettest.Builder{}
+	b.U8(22)
+	for i := 0; i < 22; i++ {
+		results(b, i)
+		b.U32(uint32(80500 + i))
+		stints(b, i)
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*final_classification.Packet2021)
+	if p.NumCars != 22 {
+		t.Errorf("%v: %v cars", layout.Name, p.NumCars)
+	}
+	actual, visual := wantStints()
+	for i, data := range p.ClassificationData {
+		want := final_classification.FinalClassificationData2021{
+			Position:         uint8(i + 1),
+			NumLaps:          58,
+			GridPosition:     uint8(22 - i),
+			Points:           uint8(25 - i),
+			NumPitStops:      uint8(i % 3),
+			ResultStatus:     lap_data.ResultStatusFinished,
+			BestLapTime:      uint32(80500 + i),
+			TotalRaceTime:    5400.125 + float64(i),
+			PenaltiesTime:    uint8(i % 6),
+			NumPenalties:     uint8(i % 2),
+			NumTyreStints:    3,
+			TyreStintsActual: actual,
+			TyreStintsVisual: visual,
+		}
+		if data != want {
+			t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, data, want)
+		}
+	}
+}
+
+func TestGolden2022(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2022, common.PacketIDFinalClassification)
+	b := &packettest.Builder{}
+	b.U8(22)
+	for i := 0; i < 22; i++ {
+		results(b, i)
+		b.U32(uint32(80500 + i))
+		stints(b, i)
+		for s := 0; s < 8; s++ {
+			b.U8(uint8(10*s + i))
+		}
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*final_classification.Packet2022)
+	actual, visual := wantStints()
+	for i, data := range p.ClassificationData {
+		var endLaps [8]uint8
+		for s := range endLaps {
+			endLaps[s] = uint8(10*s + i)
+		}
+		want := final_classification.FinalClassificationData2022{
+			Position:          uint8(i + 1),
+			NumLaps:           58,
+			GridPosition:      uint8(22 - i),
+			Points:            uint8(25 - i),
+			NumPitStops:       uint8(i % 3),
+			ResultStatus:      lap_data.ResultStatusFinished,
+			BestLapTime:       uint32(80500 + i),
+			TotalRaceTime:     5400.125 + float64(i),
+			PenaltiesTime:     uint8(i % 6),
+			NumPenalties:      uint8(i % 2),
+			NumTyreStints:     3,
+			TyreStintsActual:  actual,
+			TyreStintsVisual:  visual,
+			TyreStintsEndLaps: endLaps,
+		}
+		if data != want {
+			t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, data, want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Package arrays a layout of nested and primitive arrays, for testing the parser, writer and generated decoders
+package arrays
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
+// Colour an enum, read as its underlying type
+type Colour uint8
+
+// Packet every kind of array a packet layout may hold
+type Packet struct {
+	// Grid nested array, read row by row, at offset 0
+	Grid [3][4]uint16 `packet:"0"`
+	// Colours array of enums at offset 24
+	Colours [2]Colour `packet:"1"`
+	// Floats array of floats at offset 26
+	Floats [2]float32 `packet:"2"`
+	// Ints nested array of signed ints at offset 34
+	Ints [2][2]int8 `packet:"3"`
+	// Flags array of bools at offset 38
+	Flags [3]bool `packet:"4"`
+	// Points array of structs holding arrays at offset 41
+	Points [2]Point `packet:"5"`
+	// Names array of fixed length strings at offset 49
+	Names [2]string `packet:"6" length:"4"`
+	// Label not part of the layout
+	Label string
+}
+
+// Point a struct holding an array
+type Point struct {
+	// XY array of signed ints
+	XY [2]int16 `packet:"0"`
+}
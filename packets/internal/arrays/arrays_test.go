@@ -0,0 +1,105 @@
+package arrays_test
+
+import (
+	"bytes"
+	"github.com/roryphillips/f1-telemetry-client/packets"
+	"github.com/roryphillips/f1-telemetry-client/packets/internal/arrays"
+	"reflect"
+	"testing"
+)
+
+// golden the wire format of want, built by hand
+var golden = []byte{
+	// Grid, row by row
+	0x01, 0x00, 0x02, 0x00, 0x03, 0x00, 0x04, 0x00,
+	0x05, 0x00, 0x06, 0x00, 0x07, 0x00, 0x08, 0x00,
+	0x09, 0x00, 0x0a, 0x00, 0x0b, 0x00, 0x0c, 0x01,
+	// Colours
+	0x02, 0xff,
+	// Floats, 1.5 and -2
+	0x00, 0x00, 0xc0, 0x3f, 0x00, 0x00, 0x00, 0xc0,
+	// Ints
+	0x01, 0xff, 0x7f, 0x80,
+	// Flags
+	0x01, 0x00, 0x01,
+	// Points
+	0x0a, 0x00, 0xf6, 0xff, 0x00, 0x80, 0xff, 0x7f,
+	// Names, the first filling its whole length
+	'V', 'E', 'R', 'S', 'H', 'A', 'M', 0x00,
+}
+
+var want = arrays.Packet{
+	Grid:    [3][4]uint16{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10, 11, 0x010c}},
+	Colours: [2]arrays.Colour{2, 255},
+	Floats:  [2]float32{1.5, -2},
+	Ints:    [2][2]int8{{1, -1}, {127, -128}},
+	Flags:   [3]bool{true, false, true},
+	Points:  [2]arrays.Point{{XY: [2]int16{10, -10}}, {XY: [2]int16{-32768, 32767}}},
+	Names:   [2]string{"VERS", "HAM"},
+}
+
+func TestSize(t *testing.T) {
+	size, err := packets.SizeOf(arrays.Packet{})
+	if err != nil {
+		t.Fatalf("failed to size packet: %v", err)
+	}
+	if size != len(golden) {
+		t.Errorf("SizeOf = %v, want %v", size, len(golden))
+	}
+	if (&arrays.Packet{}).PacketSize() != len(golden) {
+		t.Errorf("PacketSize() = %v, want %v", (&arrays.Packet{}).PacketSize(), len(golden))
+	}
+}
+
+func TestParse(t *testing.T) {
+	var got arrays.Packet
+	data := packets.NewPacket(golden)
+	err := packets.NewPacketParser().Parse(data, &got)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if data.Offset() != len(golden) {
+		t.Errorf("parsed %v bytes, want %v", data.Offset(), len(golden))
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parsed %+v, want %+v", got, want)
+	}
+}
+
+func TestDecodeFrom(t *testing.T) {
+	var got arrays.Packet
+	n, err := got.DecodeFrom(golden)
+	if err != nil {
+		t.Fatalf("failed to decode: %v", err)
+	}
+	if n != len(golden) {
+		t.Errorf("decoded %v bytes, want %v", n, len(golden))
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decoded %+v, want %+v", got, want)
+	}
+
+	_, err = got.DecodeFrom(golden[:len(golden)-1])
+	if err == nil {
+		t.Errorf("decoded a packet one byte short")
+	}
+}
+
+func TestEncode(t *testing.T) {
+	packet := want
+	packet.Label = "not written"
+	got, err := packets.NewPacketWriter().Encode(&packet)
+	if err != nil {
+		t.Fatalf("failed to encode: %v", err)
+	}
+	if !bytes.Equal(got, golden) {
+		t.Errorf("encoded\n%x, want\n%x", got, golden)
+	}
+
+	// A name longer than its length can't be written
+	packet.Names[0] = "VERST"
+	_, err = packets.NewPacketWriter().Encode(&packet)
+	if err == nil {
+		t.Errorf("encoded a name longer than its length")
+	}
+}
@@ -0,0 +1,89 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package arrays
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 57 {
+		return 0, fmt.Errorf("unable to decode Packet: need 57 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 57, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 57
+}
+
+// decode decode Packet from data holding at least 57 bytes
+func (p *Packet) decode(data []byte) error {
+	for i0 := range p.Grid {
+		for i1 := range p.Grid[i0] {
+			p.Grid[i0][i1] = uint16(binary.LittleEndian.Uint16(data[0+i0*8+i1*2:]))
+		}
+	}
+	for i0 := range p.Colours {
+		p.Colours[i0] = Colour(data[24+i0*1])
+	}
+	for i0 := range p.Floats {
+		p.Floats[i0] = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[26+i0*4:])))
+	}
+	for i0 := range p.Ints {
+		for i1 := range p.Ints[i0] {
+			p.Ints[i0][i1] = int8(data[34+i0*2+i1*1])
+		}
+	}
+	for i0 := range p.Flags {
+		if data[38+i0*1] > 1 {
+			return fmt.Errorf("failed to set Flags item value: unexpected byte value %v is <0 or >1", data[38+i0*1])
+		}
+		p.Flags[i0] = bool(data[38+i0*1] == 1)
+	}
+	for i0 := range p.Points {
+		if err := p.Points[i0].decode(data[41+i0*4:]); err != nil {
+			return fmt.Errorf("unable to set struct field Points item: %v", err)
+		}
+	}
+	for i0 := range p.Names {
+		if end := bytes.IndexByte(data[49+i0*4:49+i0*4+4], 0); end >= 0 {
+			p.Names[i0] = string(data[49+i0*4 : 49+i0*4+4][:end])
+		} else {
+			p.Names[i0] = string(data[49+i0*4 : 49+i0*4+4])
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Point from its wire format, returning the number of bytes read
+func (p *Point) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, fmt.Errorf("unable to decode Point: need 4 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 4, nil
+}
+
+// PacketSize size of Point on the wire in bytes
+func (p *Point) PacketSize() int {
+	return 4
+}
+
+// decode decode Point from data holding at least 4 bytes
+func (p *Point) decode(data []byte) error {
+	for i0 := range p.XY {
+		p.XY[i0] = int16(binary.LittleEndian.Uint16(data[0+i0*2:]))
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package lap_data
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// DecodeFrom decode LapData from its wire format, returning the number of bytes read
+func (p *LapData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 53 {
+		return 0, fmt.Errorf("unable to decode LapData: need 53 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 53, nil
+}
+
+// PacketSize size of LapData on the wire in bytes
+func (p *LapData) PacketSize() int {
+	return 53
+}
+
+// decode decode LapData from data holding at least 53 bytes
+func (p *LapData) decode(data []byte) error {
+	p.LastLapTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[0:])))
+	p.CurrentLapTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[4:])))
+	p.Sector1Time = uint16(binary.LittleEndian.Uint16(data[8:]))
+	p.Sector2Time = uint16(binary.LittleEndian.Uint16(data[10:]))
+	p.BestLapTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[12:])))
+	p.BestLapNum = uint8(data[16])
+	p.BestLapSector1Time = uint16(binary.LittleEndian.Uint16(data[17:]))
+	p.BestLapSector2Time = uint16(binary.LittleEndian.Uint16(data[19:]))
+	p.BestLapSector3Time = uint16(binary.LittleEndian.Uint16(data[21:]))
+	p.BestOverallSector1Time = uint16(binary.LittleEndian.Uint16(data[23:]))
+	p.BestOverallSector1Lap = uint8(data[25])
+	p.BestOverallSector2Time = uint16(binary.LittleEndian.Uint16(data[26:]))
+	p.BestOverallSector2Lap = uint8(data[28])
+	p.BestOverallSector3Time = uint16(binary.LittleEndian.Uint16(data[29:]))
+	p.BestOverallSector3Lap = uint8(data[31])
+	p.LapDistance = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[32:])))
+	p.TotalDistance = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[36:])))
+	p.SafetyCarDelta = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[40:])))
+	p.CarPosition = uint8(data[44])
+	p.CurrentLapNum = uint8(data[45])
+	p.PitStatus = PitStatus(data[46])
+	p.Sector = Sector(data[47])
+	if data[48] > 1 {
+		return fmt.Errorf("failed to set CurrentLapInvalid value: unexpected byte value %v is <0 or >1", data[48])
+	}
+	p.CurrentLapInvalid = bool(data[48] == 1)
+	p.Penalties = uint8(data[49])
+	p.GridPosition = uint8(data[50])
+	p.DriverStatus = DriverStatus(data[51])
+	p.ResultStatus = ResultStatus(data[52])
+	return nil
+}
+
+// DecodeFrom decode LapData2019 from its wire format, returning the number of bytes read
+func (p *LapData2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 41 {
+		return 0, fmt.Errorf("unable to decode LapData2019: need 41 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 41, nil
+}
+
+// PacketSize size of LapData2019 on the wire in bytes
+func (p *LapData2019) PacketSize() int {
+	return 41
+}
+
+// decode decode LapData2019 from data holding at least 41 bytes
+func (p *LapData2019) decode(data []byte) error {
+	p.LastLapTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[0:])))
+	p.CurrentLapTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[4:])))
+	p.BestLapTime = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[8:])))
+	p.Sector1Time = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[12:])))
+	p.Sector2Time = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[16:])))
+	p.LapDistance = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[20:])))
+	p.TotalDistance = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[24:])))
+	p.SafetyCarDelta = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[28:])))
+	p.CarPosition = uint8(data[32])
+	p.CurrentLapNum = uint8(data[33])
+	p.PitStatus = PitStatus(data[34])
+	p.Sector = Sector(data[35])
+	if data[36] > 1 {
+		return fmt.Errorf("failed to set CurrentLapInvalid value: unexpected byte value %v is <0 or >1", data[36])
+	}
+	p.CurrentLapInvalid = bool(data[36] == 1)
+	p.Penalties = uint8(data[37])
+	p.GridPosition = uint8(data[38])
+	p.DriverStatus = DriverStatus(data[39])
+	p.ResultStatus = ResultStatus(data[40])
+	return nil
+}
+
+// DecodeFrom decode LapData2021 from its wire format, returning the number of bytes read
+func (p *LapData2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 43 {
+		return 0, fmt.Errorf("unable to decode LapData2021: need 43 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 43, nil
+}
+
+// PacketSize size of LapData2021 on the wire in bytes
+func (p *LapData2021) PacketSize() int {
+	return 43
+}
+
+// decode decode LapData2021 from data holding at least 43 bytes
+func (p *LapData2021) decode(data []byte) error {
+	p.LastLapTime = uint32(binary.LittleEndian.Uint32(data[0:]))
+	p.CurrentLapTime = uint32(binary.LittleEndian.Uint32(data[4:]))
+	p.Sector1Time = uint16(binary.LittleEndian.Uint16(data[8:]))
+	p.Sector2Time = uint16(binary.LittleEndian.Uint16(data[10:]))
+	p.LapDistance = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[12:])))
+	p.TotalDistance = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[16:])))
+	p.SafetyCarDelta = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[20:])))
+	p.CarPosition = uint8(data[24])
+	p.CurrentLapNum = uint8(data[25])
+	p.PitStatus = PitStatus(data[26])
+	p.NumPitStops = uint8(data[27])
+	p.Sector = Sector(data[28])
+	if data[29] > 1 {
+		return fmt.Errorf("failed to set CurrentLapInvalid value: unexpected byte value %v is <0 or >1", data[29])
+	}
+	p.CurrentLapInvalid = bool(data[29] == 1)
+	p.Penalties = uint8(data[30])
+	p.Warnings = uint8(data[31])
+	p.NumUnservedDriveThroughPens = uint8(data[32])
+	p.NumUnservedStopGoPens = uint8(data[33])
+	p.GridPosition = uint8(data[34])
+	p.DriverStatus = DriverStatus(data[35])
+	p.ResultStatus = ResultStatus(data[36])
+	if data[37] > 1 {
+		return fmt.Errorf("failed to set PitLaneTimerActive value: unexpected byte value %v is <0 or >1", data[37])
+	}
+	p.PitLaneTimerActive = bool(data[37] == 1)
+	p.PitLaneTimeInLane = uint16(binary.LittleEndian.Uint16(data[38:]))
+	p.PitStopTimer = uint16(binary.LittleEndian.Uint16(data[40:]))
+	if data[42] > 1 {
+		return fmt.Errorf("failed to set PitStopShouldServePen value: unexpected byte value %v is <0 or >1", data[42])
+	}
+	p.PitStopShouldServePen = bool(data[42] == 1)
+	return nil
+}
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1166 {
+		return 0, fmt.Errorf("unable to decode Packet: need 1166 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1166, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 1166
+}
+
+// decode decode Packet from data holding at least 1166 bytes
+func (p *Packet) decode(data []byte) error {
+	for i0 := range p.LapData {
+		if err := p.LapData[i0].decode(data[0+i0*53:]); err != nil {
+			return fmt.Errorf("unable to set struct field LapData item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2019 from its wire format, returning the number of bytes read
+func (p *Packet2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 820 {
+		return 0, fmt.Errorf("unable to decode Packet2019: need 820 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 820, nil
+}
+
+// PacketSize size of Packet2019 on the wire in bytes
+func (p *Packet2019) PacketSize() int {
+	return 820
+}
+
+// decode decode Packet2019 from data holding at least 820 bytes
+func (p *Packet2019) decode(data []byte) error {
+	for i0 := range p.LapData {
+		if err := p.LapData[i0].decode(data[0+i0*41:]); err != nil {
+			return fmt.Errorf("unable to set struct field LapData item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2021 from its wire format, returning the number of bytes read
+func (p *Packet2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 946 {
+		return 0, fmt.Errorf("unable to decode Packet2021: need 946 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 946, nil
+}
+
+// PacketSize size of Packet2021 on the wire in bytes
+func (p *Packet2021) PacketSize() int {
+	return 946
+}
+
+// decode decode Packet2021 from data holding at least 946 bytes
+func (p *Packet2021) decode(data []byte) error {
+	for i0 := range p.LapData {
+		if err := p.LapData[i0].decode(data[0+i0*43:]); err != nil {
+			return fmt.Errorf("unable to set struct field LapData item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2022 from its wire format, returning the number of bytes read
+func (p *Packet2022) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 948 {
+		return 0, fmt.Errorf("unable to decode Packet2022: need 948 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 948, nil
+}
+
+// PacketSize size of Packet2022 on the wire in bytes
+func (p *Packet2022) PacketSize() int {
+	return 948
+}
+
+// decode decode Packet2022 from data holding at least 948 bytes
+func (p *Packet2022) decode(data []byte) error {
+	for i0 := range p.LapData {
+		if err := p.LapData[i0].decode(data[0+i0*43:]); err != nil {
+			return fmt.Errorf("unable to set struct field LapData item: %v", err)
+		}
+	}
+	p.TimeTrialPBCarIdx = uint8(data[946])
+	p.TimeTrialRivalCarIdx = uint8(data[947])
+	return nil
+}
@@ -1,5 +1,7 @@
 package lap_data
 
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/enum-gen
+
 type PitStatus uint8
 
 const (
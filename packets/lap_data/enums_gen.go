@@ -0,0 +1,257 @@
+// Code generated by enum-gen. DO NOT EDIT.
+
+package lap_data
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var pitStatusNames = map[PitStatus]string{
+	0: "none",
+	1: "pitting",
+	2: "in_pit_area",
+}
+
+var pitStatusValues = map[string]PitStatus{
+	"none":        0,
+	"pitting":     1,
+	"in_pit_area": 2,
+}
+
+// String name of the PitStatus, or the type and number for unknown values
+func (v PitStatus) String() string {
+	if name, ok := pitStatusNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("PitStatus(%d)", v)
+}
+
+// MarshalText name of the PitStatus, or the number for unknown values
+func (v PitStatus) MarshalText() ([]byte, error) {
+	if name, ok := pitStatusNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the PitStatus from its name or number
+func (v *PitStatus) UnmarshalText(text []byte) error {
+	if val, ok := pitStatusValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown PitStatus %q", text)
+	}
+	*v = PitStatus(n)
+	return nil
+}
+
+// MarshalJSON name of the PitStatus as a string, or its number for unknown values
+func (v PitStatus) MarshalJSON() ([]byte, error) {
+	if name, ok := pitStatusNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the PitStatus from its name as a string, or its number
+func (v *PitStatus) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var sectorNames = map[Sector]string{
+	0: "sector_1",
+	1: "sector_2",
+	2: "sector_3",
+}
+
+var sectorValues = map[string]Sector{
+	"sector_1": 0,
+	"sector_2": 1,
+	"sector_3": 2,
+}
+
+// String name of the Sector, or the type and number for unknown values
+func (v Sector) String() string {
+	if name, ok := sectorNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("Sector(%d)", v)
+}
+
+// MarshalText name of the Sector, or the number for unknown values
+func (v Sector) MarshalText() ([]byte, error) {
+	if name, ok := sectorNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the Sector from its name or number
+func (v *Sector) UnmarshalText(text []byte) error {
+	if val, ok := sectorValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown Sector %q", text)
+	}
+	*v = Sector(n)
+	return nil
+}
+
+// MarshalJSON name of the Sector as a string, or its number for unknown values
+func (v Sector) MarshalJSON() ([]byte, error) {
+	if name, ok := sectorNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the Sector from its name as a string, or its number
+func (v *Sector) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var driverStatusNames = map[DriverStatus]string{
+	0: "in_garage",
+	1: "flying_lap",
+	2: "in_lap",
+	3: "out_lap",
+	4: "on_track",
+}
+
+var driverStatusValues = map[string]DriverStatus{
+	"in_garage":  0,
+	"flying_lap": 1,
+	"in_lap":     2,
+	"out_lap":    3,
+	"on_track":   4,
+}
+
+// String name of the DriverStatus, or the type and number for unknown values
+func (v DriverStatus) String() string {
+	if name, ok := driverStatusNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("DriverStatus(%d)", v)
+}
+
+// MarshalText name of the DriverStatus, or the number for unknown values
+func (v DriverStatus) MarshalText() ([]byte, error) {
+	if name, ok := driverStatusNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the DriverStatus from its name or number
+func (v *DriverStatus) UnmarshalText(text []byte) error {
+	if val, ok := driverStatusValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown DriverStatus %q", text)
+	}
+	*v = DriverStatus(n)
+	return nil
+}
+
+// MarshalJSON name of the DriverStatus as a string, or its number for unknown values
+func (v DriverStatus) MarshalJSON() ([]byte, error) {
+	if name, ok := driverStatusNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the DriverStatus from its name as a string, or its number
+func (v *DriverStatus) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var resultStatusNames = map[ResultStatus]string{
+	0: "invalid",
+	1: "inactive",
+	2: "active",
+	3: "finished",
+	4: "disqualified",
+	5: "not_classified",
+	6: "retired",
+}
+
+var resultStatusValues = map[string]ResultStatus{
+	"invalid":        0,
+	"inactive":       1,
+	"active":         2,
+	"finished":       3,
+	"disqualified":   4,
+	"not_classified": 5,
+	"retired":        6,
+}
+
+// String name of the ResultStatus, or the type and number for unknown values
+func (v ResultStatus) String() string {
+	if name, ok := resultStatusNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("ResultStatus(%d)", v)
+}
+
+// MarshalText name of the ResultStatus, or the number for unknown values
+func (v ResultStatus) MarshalText() ([]byte, error) {
+	if name, ok := resultStatusNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the ResultStatus from its name or number
+func (v *ResultStatus) UnmarshalText(text []byte) error {
+	if val, ok := resultStatusValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown ResultStatus %q", text)
+	}
+	*v = ResultStatus(n)
+	return nil
+}
+
+// MarshalJSON name of the ResultStatus as a string, or its number for unknown values
+func (v ResultStatus) MarshalJSON() ([]byte, error) {
+	if name, ok := resultStatusNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the ResultStatus from its name as a string, or its number
+func (v *ResultStatus) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
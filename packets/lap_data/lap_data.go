@@ -0,0 +1,73 @@
+package lap_data
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet lap data
+type Packet struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// LapData for all cars on track
+	LapData [22]LapData `json:"lap_data" packet:"0"`
+}
+
+// LapData per-car lap data
+type LapData struct {
+	// LastLapTime Last lap time in seconds
+	LastLapTime float32 `json:"last_lap_time" packet:"0"`
+	// CurrentLapTime Current time around the lap in seconds
+	CurrentLapTime float32 `json:"current_lap_time" packet:"1"`
+	// Sector1Time Sector 1 time in milliseconds
+	Sector1Time uint16 `json:"sector1_time" packet:"2"`
+	// Sector2Time Sector 2 time in milliseconds
+	Sector2Time uint16 `json:"sector2_time" packet:"3"`
+	// BestLapTime Best lap time of the session in seconds
+	BestLapTime float32 `json:"best_lap_time" packet:"4"`
+	// BestLapNum Lap number best time achieved on
+	BestLapNum uint8 `json:"best_lap_num" packet:"5"`
+	// BestLapSector1Time Sector 1 time of the best lap of the session in milliseconds
+	BestLapSector1Time uint16 `json:"best_lap_sector1_time" packet:"6"`
+	// BestLapSector2Time Sector 2 time of the best lap of the session in milliseconds
+	BestLapSector2Time uint16 `json:"best_lap_sector2_time" packet:"7"`
+	// BestLapSector3Time Sector 3 time of the best lap of the session in milliseconds
+	BestLapSector3Time uint16 `json:"best_lap_sector3_time" packet:"8"`
+	// BestOverallSector1Time Best overall sector 1 time of the session
+	BestOverallSector1Time uint16 `json:"best_overall_sector1_time" packet:"9"`
+	// BestOverallSector1Lap Lap number best overall sector 1 time achieved on
+	BestOverallSector1Lap uint8 `json:"best_overall_sector1_lap" packet:"10"`
+	// BestOverallSector2Time Best overall sector 2 time of the session
+	BestOverallSector2Time uint16 `json:"best_overall_sector2_time" packet:"11"`
+	// BestOverallSector2Lap Lap number best overall sector 2 time achieved on
+	BestOverallSector2Lap uint8 `json:"best_overall_sector2_lap" packet:"12"`
+	// BestOverallSector3Time Best overall sector 3 time of the session
+	BestOverallSector3Time uint16 `json:"best_overall_sector3_time" packet:"13"`
+	// BestOverallSector3Lap Lap number best overall sector 3 time achieved on
+	BestOverallSector3Lap uint8 `json:"best_overall_sector3_lap" packet:"14"`
+	// LapDistance Distance vehicle is around current lap in metres - may be negative if the line isn't crossed yet
+	LapDistance float32 `json:"lap_distance" packet:"15"`
+	// TotalDistance Distance travelled in the session in metres - may be negative if the line isn't crossed yet
+	TotalDistance float32 `json:"total_distance" packet:"16"`
+	// SafetyCarDelta Delta in seconds for the safety car
+	SafetyCarDelta float32 `json:"safety_car_delta" packet:"17"`
+	// CarPosition Car race position
+	CarPosition uint8 `json:"car_position" packet:"18"`
+	// CurrentLapNum Current lap number
+	CurrentLapNum uint8 `json:"current_lap_num" packet:"19"`
+	// PitStatus Whether the car is in or entering the pits
+	PitStatus PitStatus `json:"pit_status" packet:"20"`
+	// Sector Sector the car is currently in
+	Sector Sector `json:"sector" packet:"21"`
+	// CurrentLapInvalid Whether the current lap has been invalidated
+	CurrentLapInvalid bool `json:"current_lap_invalid" packet:"22"`
+	// Penalties Accumulated time penalties in seconds to be added
+	Penalties uint8 `json:"penalties" packet:"23"`
+	// GridPosition Grid position the car started the race in
+	GridPosition uint8 `json:"grid_position" packet:"24"`
+	// DriverStatus Status of the driver
+	DriverStatus DriverStatus `json:"driver_status" packet:"25"`
+	// ResultStatus Result status of the car
+	ResultStatus ResultStatus `json:"result_status" packet:"26"`
+}
@@ -0,0 +1,51 @@
+package lap_data
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet2019 F1 2019 lap data
+type Packet2019 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// LapData for all cars on track
+	LapData [20]LapData2019 `json:"lap_data" packet:"0"`
+}
+
+// LapData2019 F1 2019 per-car lap data
+type LapData2019 struct {
+	// LastLapTime Last lap time in seconds
+	LastLapTime float32 `json:"last_lap_time" packet:"0"`
+	// CurrentLapTime Current time around the lap in seconds
+	CurrentLapTime float32 `json:"current_lap_time" packet:"1"`
+	// BestLapTime Best lap time of the session in seconds
+	BestLapTime float32 `json:"best_lap_time" packet:"2"`
+	// Sector1Time Sector 1 time in seconds
+	Sector1Time float32 `json:"sector1_time" packet:"3"`
+	// Sector2Time Sector 2 time in seconds
+	Sector2Time float32 `json:"sector2_time" packet:"4"`
+	// LapDistance Distance vehicle is around current lap in metres - may be negative if the line isn't crossed yet
+	LapDistance float32 `json:"lap_distance" packet:"5"`
+	// TotalDistance Distance travelled in the session in metres - may be negative if the line isn't crossed yet
+	TotalDistance float32 `json:"total_distance" packet:"6"`
+	// SafetyCarDelta Delta in seconds for the safety car
+	SafetyCarDelta float32 `json:"safety_car_delta" packet:"7"`
+	// CarPosition Car race position
+	CarPosition uint8 `json:"car_position" packet:"8"`
+	// CurrentLapNum Current lap number
+	CurrentLapNum uint8 `json:"current_lap_num" packet:"9"`
+	// PitStatus Whether the car is in or entering the pits
+	PitStatus PitStatus `json:"pit_status" packet:"10"`
+	// Sector Sector the car is currently in
+	Sector Sector `json:"sector" packet:"11"`
+	// CurrentLapInvalid Whether the current lap has been invalidated
+	CurrentLapInvalid bool `json:"current_lap_invalid" packet:"12"`
+	// Penalties Accumulated time penalties in seconds to be added
+	Penalties uint8 `json:"penalties" packet:"13"`
+	// GridPosition Grid position the car started the race in
+	GridPosition uint8 `json:"grid_position" packet:"14"`
+	// DriverStatus Status of the driver
+	DriverStatus DriverStatus `json:"driver_status" packet:"15"`
+	// ResultStatus Result status of the car
+	ResultStatus ResultStatus `json:"result_status" packet:"16"`
+}
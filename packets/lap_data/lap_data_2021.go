@@ -0,0 +1,65 @@
+package lap_data
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet2021 F1 2021 lap data
+type Packet2021 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// LapData for all cars on track
+	LapData [22]LapData2021 `json:"lap_data" packet:"0"`
+}
+
+// LapData2021 F1 2021 per-car lap data, also used by F1 2022
+type LapData2021 struct {
+	// LastLapTime Last lap time in milliseconds
+	LastLapTime uint32 `json:"last_lap_time" packet:"0"`
+	// CurrentLapTime Current time around the lap in milliseconds
+	CurrentLapTime uint32 `json:"current_lap_time" packet:"1"`
+	// Sector1Time Sector 1 time in milliseconds
+	Sector1Time uint16 `json:"sector1_time" packet:"2"`
+	// Sector2Time Sector 2 time in milliseconds
+	Sector2Time uint16 `json:"sector2_time" packet:"3"`
+	// LapDistance Distance vehicle is around current lap in metres - may be negative if the line isn't crossed yet
+	LapDistance float32 `json:"lap_distance" packet:"4"`
+	// TotalDistance Distance travelled in the session in metres - may be negative if the line isn't crossed yet
+	TotalDistance float32 `json:"total_distance" packet:"5"`
+	// SafetyCarDelta Delta in seconds for the safety car
+	SafetyCarDelta float32 `json:"safety_car_delta" packet:"6"`
+	// CarPosition Car race position
+	CarPosition uint8 `json:"car_position" packet:"7"`
+	// CurrentLapNum Current lap number
+	CurrentLapNum uint8 `json:"current_lap_num" packet:"8"`
+	// PitStatus Whether the car is in or entering the pits
+	PitStatus PitStatus `json:"pit_status" packet:"9"`
+	// NumPitStops Number of pit stops taken in this race
+	NumPitStops uint8 `json:"num_pit_stops" packet:"10"`
+	// Sector Sector the car is currently in
+	Sector Sector `json:"sector" packet:"11"`
+	// CurrentLapInvalid Whether the current lap has been invalidated
+	CurrentLapInvalid bool `json:"current_lap_invalid" packet:"12"`
+	// Penalties Accumulated time penalties in seconds to be added
+	Penalties uint8 `json:"penalties" packet:"13"`
+	// Warnings Accumulated number of warnings issued
+	Warnings uint8 `json:"warnings" packet:"14"`
+	// NumUnservedDriveThroughPens Number of drive through penalties left to serve
+	NumUnservedDriveThroughPens uint8 `json:"num_unserved_drive_through_pens" packet:"15"`
+	// NumUnservedStopGoPens Number of stop go penalties left to serve
+	NumUnservedStopGoPens uint8 `json:"num_unserved_stop_go_pens" packet:"16"`
+	// GridPosition Grid position the car started the race in
+	GridPosition uint8 `json:"grid_position" packet:"17"`
+	// DriverStatus Status of the driver
+	DriverStatus DriverStatus `json:"driver_status" packet:"18"`
+	// ResultStatus Result status of the car
+	ResultStatus ResultStatus `json:"result_status" packet:"19"`
+	// PitLaneTimerActive Whether the pit lane timing is active
+	PitLaneTimerActive bool `json:"pit_lane_timer_active" packet:"20"`
+	// PitLaneTimeInLane Current time spent in the pit lane in milliseconds
+	PitLaneTimeInLane uint16 `json:"pit_lane_time_in_lane" packet:"21"`
+	// PitStopTimer Time of the actual pit stop in milliseconds
+	PitStopTimer uint16 `json:"pit_stop_timer" packet:"22"`
+	// PitStopShouldServePen Whether the car should serve a penalty at this stop
+	PitStopShouldServePen bool `json:"pit_stop_should_serve_pen" packet:"23"`
+}
@@ -0,0 +1,17 @@
+package lap_data
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet2022 F1 2022 lap data
+type Packet2022 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// LapData for all cars on track
+	LapData [22]LapData2021 `json:"lap_data" packet:"0"`
+	// TimeTrialPBCarIdx Index of the personal best car in time trial, 255 if invalid
+	TimeTrialPBCarIdx uint8 `json:"time_trial_pb_car_idx" packet:"1"`
+	// TimeTrialRivalCarIdx Index of the rival car in time trial, 255 if invalid
+	TimeTrialRivalCarIdx uint8 `json:"time_trial_rival_car_idx" packet:"2"`
+}
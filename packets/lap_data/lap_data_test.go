@@ -0,0 +1,182 @@
+package lap_data_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/lap_data"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"testing"
+)
+
+// status append the fields from CarPosition, which every format has, derived from car i
+func status(b *packettest.Builder, i int) {
+	b.U8(uint8(i + 1)).U8(uint8(i + 2))
+	b.U8(uint8(lap_data.PitStatusPitting))
+}
+
+func TestGolden2019(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2019, common.PacketIDLapData)
+	b := &packettest.Builder{}
+	for i := 0; i < 20; i++ {
+		f := float32(i * 10)
+		b.F32(f + 1).F32(f + 2).F32(f + 3).F32(f + 4).F32(f + 5).F32(f + 6).F32(f + 7).F32(f + 8)
+		status(b, i)
+		b.U8(uint8(lap_data.Sector3)).Bool(i%2 == 1).U8(uint8(i + 3)).U8(uint8(i + 4))
+		b.U8(uint8(lap_data.DriverStatusOnTrack)).U8(uint8(lap_data.ResultStatusActive))
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*lap_data.Packet2019)
+	for i, lap := range p.LapData {
+		f := float32(i * 10)
+		want := lap_data.LapData2019{
+			LastLapTime:       f + 1,
+			CurrentLapTime:    f + 2,
+			BestLapTime:       f + 3,
+			Sector1Time:       f + 4,
+			Sector2Time:       f + 5,
+			LapDistance:       f + 6,
+			TotalDistance:     f + 7,
+			SafetyCarDelta:    f + 8,
+			CarPosition:       uint8(i + 1),
+			CurrentLapNum:     uint8(i + 2),
+			PitStatus:         lap_data.PitStatusPitting,
+			Sector:            lap_data.Sector3,
+			CurrentLapInvalid: i%2 == 1,
+			Penalties:         uint8(i + 3),
+			GridPosition:      uint8(i + 4),
+			DriverStatus:      lap_data.DriverStatusOnTrack,
+			ResultStatus:      lap_data.ResultStatusActive,
+		}
+		if lap != want {
+			t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, lap, want)
+		}
+	}
+}
+
+func TestGolden2020(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2020, common.PacketIDLapData)
+	b := &packettest.Builder{}
+	for i := 0; i < 22; i++ {
+		f := float32(i * 10)
+		u := uint16(i * 1000)
+		b.F32(f + 1).F32(f + 2).U16(u + 3).U16(u + 4)
+		b.F32(f + 5).U8(uint8(i + 6)).U16(u + 7).U16(u + 8).U16(u + 9)
+		b.U16(u + 10).U8(uint8(i + 11)).U16(u + 12).U8(uint8(i + 13)).U16(u + 14).U8(uint8(i + 15))
+		b.F32(f + 16).F32(f + 17).F32(f + 18)
+		status(b, i)
+		b.U8(uint8(lap_data.Sector2)).Bool(true).U8(5).U8(uint8(22 - i))
+		b.U8(uint8(lap_data.DriverStatusInLap)).U8(uint8(lap_data.ResultStatusFinished))
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*lap_data.Packet)
+	for i, lap := range p.LapData {
+		f := float32(i * 10)
+		u := uint16(i * 1000)
+		want := lap_data.LapData{
+			LastLapTime:            f + 1,
+			CurrentLapTime:         f + 2,
+			Sector1Time:            u + 3,
+			Sector2Time:            u + 4,
+			BestLapTime:            f + 5,
+			BestLapNum:             uint8(i + 6),
+			BestLapSector1Time:     u + 7,
+			BestLapSector2Time:     u + 8,
+			BestLapSector3Time:     u + 9,
+			BestOverallSector1Time: u + 10,
+			BestOverallSector1Lap:  uint8(i + 11),
+			BestOverallSector2Time: u + 12,
+			BestOverallSector2Lap:  uint8(i + 13),
+			BestOverallSector3Time: u + 14,
+			BestOverallSector3Lap:  uint8(i + 15),
+			LapDistance:            f + 16,
+			TotalDistance:          f + 17,
+			SafetyCarDelta:         f + 18,
+			CarPosition:            uint8(i + 1),
+			CurrentLapNum:          uint8(i + 2),
+			PitStatus:              lap_data.PitStatusPitting,
+			Sector:                 lap_data.Sector2,
+			CurrentLapInvalid:      true,
+			Penalties:              5,
+			GridPosition:           uint8(22 - i),
+			DriverStatus:           lap_data.DriverStatusInLap,
+			ResultStatus:           lap_data.ResultStatusFinished,
+		}
+		if lap != want {
+			t.Errorf("%v: car %v is %+v, want %+v", layout.Name, i, lap, want)
+		}
+	}
+}
+
+// lap2021 append the lap data of car i in the 2021 layout, which 2022 also uses
+func lap2021(b *packettest.Builder, i int) {
+	b.U32(uint32(90000 + i)).U32(uint32(30000 + i)).U16(uint16(25000 + i)).U16(uint16(31000 + i))
+	b.F32(float32(i) + 0.5).F32(float32(i) + 5000.5).F32(float32(-i))
+	status(b, i)
+	b.U8(uint8(i % 3)).U8(uint8(lap_data.Sector1)).Bool(false).U8(uint8(i + 3)).U8(uint8(i + 4))
+	b.U8(uint8(i + 5)).U8(uint8(i + 6)).U8(uint8(i + 7))
+	b.U8(uint8(lap_data.DriverStatusOutLap)).U8(uint8(lap_data.ResultStatusDisqualified))
+	b.Bool(true).U16(uint16(1200 + i)).U16(uint16(2300 + i)).Bool(i%2 == 0)
+}
+
+// checkLap2021 whether the lap data of car i holds the values appended by lap2021
+func checkLap2021(t *testing.T, name string, i int, lap lap_data.LapData2021) {
+	t.Helper()
+	want := lap_data.LapData2021{
+		LastLapTime:                 uint32(90000 + i),
+		CurrentLapTime:              uint32(30000 + i),
+		Sector1Time:                 uint16(25000 + i),
+		Sector2Time:                 uint16(31000 + i),
+		LapDistance:                 float32(i) + 0.5,
+		TotalDistance:               float32(i) + 5000.5,
+		SafetyCarDelta:              float32(-i),
+		CarPosition:                 uint8(i + 1),
+		CurrentLapNum:               uint8(i + 2),
+		PitStatus:                   lap_data.PitStatusPitting,
+		NumPitStops:                 uint8(i % 3),
+		Sector:                      lap_data.Sector1,
+		CurrentLapInvalid:           false,
+		Penalties:                   uint8(i + 3),
+		Warnings:                    uint8(i + 4),
+		NumUnservedDriveThroughPens: uint8(i + 5),
+		NumUnservedStopGoPens:       uint8(i + 6),
+		GridPosition:                uint8(i + 7),
+		DriverStatus:                lap_data.DriverStatusOutLap,
+		ResultStatus:                lap_data.ResultStatusDisqualified,
+		PitLaneTimerActive:          true,
+		PitLaneTimeInLane:           uint16(1200 + i),
+		PitStopTimer:                uint16(2300 + i),
+		PitStopShouldServePen:       i%2 == 0,
+	}
+	if lap != want {
+		t.Errorf("%v: car %v is %+v, want %+v", name, i, lap, want)
+	}
+}
+
+func TestGolden2021(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2021, common.PacketIDLapData)
+	b := &packettest.Builder{}
+	for i := 0; i < 22; i++ {
+		lap2021(b, i)
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*lap_data.Packet2021)
+	for i, lap := range p.LapData {
+		checkLap2021(t, layout.Name, i, lap)
+	}
+}
+
+func TestGolden2022(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2022, common.PacketIDLapData)
+	b := &packettest.Builder{}
+	for i := 0; i < 22; i++ {
+		lap2021(b, i)
+	}
+	b.U8(3).U8(255)
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*lap_data.Packet2022)
+	for i, lap := range p.LapData {
+		checkLap2021(t, layout.Name, i, lap)
+	}
+	if p.TimeTrialPBCarIdx != 3 || p.TimeTrialRivalCarIdx != 255 {
+		t.Errorf("%v: time trial PB car %v, rival car %v", layout.Name, p.TimeTrialPBCarIdx, p.TimeTrialRivalCarIdx)
+	}
+}
@@ -0,0 +1,129 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package lobby_info
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// DecodeFrom decode LobbyInfoData from its wire format, returning the number of bytes read
+func (p *LobbyInfoData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 52 {
+		return 0, fmt.Errorf("unable to decode LobbyInfoData: need 52 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 52, nil
+}
+
+// PacketSize size of LobbyInfoData on the wire in bytes
+func (p *LobbyInfoData) PacketSize() int {
+	return 52
+}
+
+// decode decode LobbyInfoData from data holding at least 52 bytes
+func (p *LobbyInfoData) decode(data []byte) error {
+	if data[0] > 1 {
+		return fmt.Errorf("failed to set AIControlled value: unexpected byte value %v is <0 or >1", data[0])
+	}
+	p.AIControlled = bool(data[0] == 1)
+	p.TeamID = uint8(data[1])
+	p.Nationality = uint8(data[2])
+	if end := bytes.IndexByte(data[3:3+48], 0); end >= 0 {
+		p.Name = string(data[3 : 3+48][:end])
+	} else {
+		p.Name = string(data[3 : 3+48])
+	}
+	p.ReadyStatus = ReadyStatus(data[51])
+	return nil
+}
+
+// DecodeFrom decode LobbyInfoData2021 from its wire format, returning the number of bytes read
+func (p *LobbyInfoData2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 53 {
+		return 0, fmt.Errorf("unable to decode LobbyInfoData2021: need 53 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 53, nil
+}
+
+// PacketSize size of LobbyInfoData2021 on the wire in bytes
+func (p *LobbyInfoData2021) PacketSize() int {
+	return 53
+}
+
+// decode decode LobbyInfoData2021 from data holding at least 53 bytes
+func (p *LobbyInfoData2021) decode(data []byte) error {
+	if data[0] > 1 {
+		return fmt.Errorf("failed to set AIControlled value: unexpected byte value %v is <0 or >1", data[0])
+	}
+	p.AIControlled = bool(data[0] == 1)
+	p.TeamID = uint8(data[1])
+	p.Nationality = uint8(data[2])
+	if end := bytes.IndexByte(data[3:3+48], 0); end >= 0 {
+		p.Name = string(data[3 : 3+48][:end])
+	} else {
+		p.Name = string(data[3 : 3+48])
+	}
+	p.CarNumber = uint8(data[51])
+	p.ReadyStatus = ReadyStatus(data[52])
+	return nil
+}
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1145 {
+		return 0, fmt.Errorf("unable to decode Packet: need 1145 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1145, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 1145
+}
+
+// decode decode Packet from data holding at least 1145 bytes
+func (p *Packet) decode(data []byte) error {
+	p.NumPlayers = uint8(data[0])
+	for i0 := range p.LobbyPlayers {
+		if err := p.LobbyPlayers[i0].decode(data[1+i0*52:]); err != nil {
+			return fmt.Errorf("unable to set struct field LobbyPlayers item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2021 from its wire format, returning the number of bytes read
+func (p *Packet2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1167 {
+		return 0, fmt.Errorf("unable to decode Packet2021: need 1167 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1167, nil
+}
+
+// PacketSize size of Packet2021 on the wire in bytes
+func (p *Packet2021) PacketSize() int {
+	return 1167
+}
+
+// decode decode Packet2021 from data holding at least 1167 bytes
+func (p *Packet2021) decode(data []byte) error {
+	p.NumPlayers = uint8(data[0])
+	for i0 := range p.LobbyPlayers {
+		if err := p.LobbyPlayers[i0].decode(data[1+i0*53:]); err != nil {
+			return fmt.Errorf("unable to set struct field LobbyPlayers item: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package lobby_info
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/enum-gen
+
+// ReadyStatus ready status of a player in the lobby
+type ReadyStatus uint8
+
+const (
+	// ReadyStatusNotReady not ready
+	ReadyStatusNotReady ReadyStatus = 0
+	// ReadyStatusReady ready
+	ReadyStatusReady ReadyStatus = 1
+	// ReadyStatusSpectating spectating
+	ReadyStatusSpectating ReadyStatus = 2
+)
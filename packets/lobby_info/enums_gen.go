@@ -0,0 +1,68 @@
+// Code generated by enum-gen. DO NOT EDIT.
+
+package lobby_info
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var readyStatusNames = map[ReadyStatus]string{
+	0: "not_ready",
+	1: "ready",
+	2: "spectating",
+}
+
+var readyStatusValues = map[string]ReadyStatus{
+	"not_ready":  0,
+	"ready":      1,
+	"spectating": 2,
+}
+
+// String name of the ReadyStatus, or the type and number for unknown values
+func (v ReadyStatus) String() string {
+	if name, ok := readyStatusNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("ReadyStatus(%d)", v)
+}
+
+// MarshalText name of the ReadyStatus, or the number for unknown values
+func (v ReadyStatus) MarshalText() ([]byte, error) {
+	if name, ok := readyStatusNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the ReadyStatus from its name or number
+func (v *ReadyStatus) UnmarshalText(text []byte) error {
+	if val, ok := readyStatusValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown ReadyStatus %q", text)
+	}
+	*v = ReadyStatus(n)
+	return nil
+}
+
+// MarshalJSON name of the ReadyStatus as a string, or its number for unknown values
+func (v ReadyStatus) MarshalJSON() ([]byte, error) {
+	if name, ok := readyStatusNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the ReadyStatus from its name as a string, or its number
+func (v *ReadyStatus) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
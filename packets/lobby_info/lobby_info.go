@@ -0,0 +1,49 @@
+package lobby_info
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet lobby info data
+type Packet struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// NumPlayers number of players in the lobby
+	NumPlayers uint8 `json:"num_players" packet:"0"`
+	// LobbyPlayers data for all players in the lobby
+	LobbyPlayers [22]LobbyInfoData `json:"lobby_players" packet:"1"`
+}
+
+// TeamIDs identifier of the team of each player
+func (p *Packet) TeamIDs() []uint8 {
+	ids := make([]uint8, len(p.LobbyPlayers))
+	for i, player := range p.LobbyPlayers {
+		ids[i] = player.TeamID
+	}
+	return ids
+}
+
+// NationalityIDs identifier of the nationality of each player
+func (p *Packet) NationalityIDs() []uint8 {
+	ids := make([]uint8, len(p.LobbyPlayers))
+	for i, player := range p.LobbyPlayers {
+		ids[i] = player.Nationality
+	}
+	return ids
+}
+
+// LobbyInfoData per-player lobby data
+type LobbyInfoData struct {
+	// AIControlled whether the car is controlled by the AI
+	AIControlled bool `json:"ai_controlled" packet:"0"`
+	// TeamID identifier of the team, 255 if no team selected yet
+	TeamID uint8 `json:"team_id" packet:"1"`
+	// Nationality identifier of the player's nationality
+	Nationality uint8 `json:"nationality" packet:"2"`
+	// Name name of the player
+	Name string `json:"name" packet:"3" length:"48"`
+	// ReadyStatus whether the player is ready
+	ReadyStatus ReadyStatus `json:"ready_status" packet:"4"`
+}
@@ -0,0 +1,99 @@
+package lobby_info_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/lobby_info"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// name the name of player i, player 0 using all 48 bytes of the field
+func name(i int) string {
+	if i == 0 {
+		return strings.Repeat("p", 48)
+	}
+	return "Player " + strconv.Itoa(i)
+}
+
+func TestGolden2020(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2020, common.PacketIDLobbyInfo)
+	b := &packettest.Builder{}
+	b.U8(12)
+	for i := 0; i < 22; i++ {
+		b.Bool(i >= 12).U8(uint8(i+1)).U8(uint8(i+2)).String(name(i), 48).U8(uint8(lobby_info.ReadyStatusReady))
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*lobby_info.Packet)
+	if p.NumPlayers != 12 {
+		t.Errorf("%v: %v players", layout.Name, p.NumPlayers)
+	}
+	for i, player := range p.LobbyPlayers {
+		want := lobby_info.LobbyInfoData{
+			AIControlled: i >= 12,
+			TeamID:       uint8(i + 1),
+			Nationality:  uint8(i + 2),
+			Name:         name(i),
+			ReadyStatus:  lobby_info.ReadyStatusReady,
+		}
+		if player != want {
+			t.Errorf("%v: player %v is %+v, want %+v", layout.Name, i, player, want)
+		}
+	}
+}
+
+func TestGolden2021(t *testing.T) {
+	for _, format := range []uint16{common.PacketFormat2021, common.PacketFormat2022} {
+		layout := packettest.LayoutOf(format, common.PacketIDLobbyInfo)
+		b := &packettest.Builder{}
+		b.U8(2)
+		for i := 0; i < 22; i++ {
+			b.Bool(i >= 2).U8(uint8(i+1)).U8(uint8(i+2)).String(name(i), 48).U8(uint8(i + 3)).U8(uint8(lobby_info.ReadyStatusSpectating))
+		}
+
+		p := packettest.Golden(t, layout, b.Bytes()).(*lobby_info.Packet2021)
+		if p.NumPlayers != 2 {
+			t.Errorf("%v: %v players", layout.Name, p.NumPlayers)
+		}
+		for i, player := range p.LobbyPlayers {
+			want := lobby_info.LobbyInfoData2021{
+				AIControlled: i >= 2,
+				TeamID:       uint8(i + 1),
+				Nationality:  uint8(i + 2),
+				Name:         name(i),
+				CarNumber:    uint8(i + 3),
+				ReadyStatus:  lobby_info.ReadyStatusSpectating,
+			}
+			if player != want {
+				t.Errorf("%v: player %v is %+v, want %+v", layout.Name, i, player, want)
+			}
+		}
+	}
+}
+func TestFullLengthName(t *testing.T) {
+	writer := packets.NewPacketWriter()
+	name := strings.Repeat("a", 48)
+	packet := &lobby_info.Packet2021{}
+	packet.LobbyPlayers[0].Name = name
+	data, err := writer.Encode(packet)
+	if err != nil {
+		t.Fatalf("failed to encode a %v byte name: %v", len(name), err)
+	}
+
+	decoded := &lobby_info.Packet2021{}
+	_, err = decoded.DecodeFrom(data)
+	if err != nil {
+		t.Fatalf("failed to decode: %v", err)
+	}
+	if decoded.LobbyPlayers[0].Name != name {
+		t.Errorf("decoded name %q, want %q", decoded.LobbyPlayers[0].Name, name)
+	}
+
+	packet.LobbyPlayers[0].Name = name + "a"
+	_, err = writer.Encode(packet)
+	if err == nil {
+		t.Errorf("encoded a %v byte name into 48 bytes", len(packet.LobbyPlayers[0].Name))
+	}
+}
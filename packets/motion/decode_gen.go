@@ -0,0 +1,225 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package motion
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// DecodeFrom decode CarMotionData from its wire format, returning the number of bytes read
+func (p *CarMotionData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 60 {
+		return 0, fmt.Errorf("unable to decode CarMotionData: need 60 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 60, nil
+}
+
+// PacketSize size of CarMotionData on the wire in bytes
+func (p *CarMotionData) PacketSize() int {
+	return 60
+}
+
+// decode decode CarMotionData from data holding at least 60 bytes
+func (p *CarMotionData) decode(data []byte) error {
+	if err := p.WorldPosition.decode(data[0:]); err != nil {
+		return fmt.Errorf("unable to set struct field WorldPosition: %v", err)
+	}
+	if err := p.WorldVelocity.decode(data[12:]); err != nil {
+		return fmt.Errorf("unable to set struct field WorldVelocity: %v", err)
+	}
+	if err := p.WorldForwardDir.decode(data[24:]); err != nil {
+		return fmt.Errorf("unable to set struct field WorldForwardDir: %v", err)
+	}
+	if err := p.WorldRightDir.decode(data[30:]); err != nil {
+		return fmt.Errorf("unable to set struct field WorldRightDir: %v", err)
+	}
+	p.GForceLateral = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[36:])))
+	p.GForceLongitudinal = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[40:])))
+	p.GForceVertical = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[44:])))
+	p.Yaw = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[48:])))
+	p.Pitch = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[52:])))
+	p.Roll = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[56:])))
+	return nil
+}
+
+// DecodeFrom decode NormalisedVector3 from its wire format, returning the number of bytes read
+func (p *NormalisedVector3) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 6 {
+		return 0, fmt.Errorf("unable to decode NormalisedVector3: need 6 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 6, nil
+}
+
+// PacketSize size of NormalisedVector3 on the wire in bytes
+func (p *NormalisedVector3) PacketSize() int {
+	return 6
+}
+
+// decode decode NormalisedVector3 from data holding at least 6 bytes
+func (p *NormalisedVector3) decode(data []byte) error {
+	p.X = int16(binary.LittleEndian.Uint16(data[0:]))
+	p.Y = int16(binary.LittleEndian.Uint16(data[2:]))
+	p.Z = int16(binary.LittleEndian.Uint16(data[4:]))
+	return nil
+}
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1440 {
+		return 0, fmt.Errorf("unable to decode Packet: need 1440 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1440, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 1440
+}
+
+// decode decode Packet from data holding at least 1440 bytes
+func (p *Packet) decode(data []byte) error {
+	for i0 := range p.CarMotion {
+		if err := p.CarMotion[i0].decode(data[0+i0*60:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarMotion item: %v", err)
+		}
+	}
+	if err := p.PlayerCar.decode(data[1320:]); err != nil {
+		return fmt.Errorf("unable to set struct field PlayerCar: %v", err)
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2019 from its wire format, returning the number of bytes read
+func (p *Packet2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1320 {
+		return 0, fmt.Errorf("unable to decode Packet2019: need 1320 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1320, nil
+}
+
+// PacketSize size of Packet2019 on the wire in bytes
+func (p *Packet2019) PacketSize() int {
+	return 1320
+}
+
+// decode decode Packet2019 from data holding at least 1320 bytes
+func (p *Packet2019) decode(data []byte) error {
+	for i0 := range p.CarMotion {
+		if err := p.CarMotion[i0].decode(data[0+i0*60:]); err != nil {
+			return fmt.Errorf("unable to set struct field CarMotion item: %v", err)
+		}
+	}
+	if err := p.PlayerCar.decode(data[1200:]); err != nil {
+		return fmt.Errorf("unable to set struct field PlayerCar: %v", err)
+	}
+	return nil
+}
+
+// DecodeFrom decode PlayerCarData from its wire format, returning the number of bytes read
+func (p *PlayerCarData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 120 {
+		return 0, fmt.Errorf("unable to decode PlayerCarData: need 120 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 120, nil
+}
+
+// PacketSize size of PlayerCarData on the wire in bytes
+func (p *PlayerCarData) PacketSize() int {
+	return 120
+}
+
+// decode decode PlayerCarData from data holding at least 120 bytes
+func (p *PlayerCarData) decode(data []byte) error {
+	if err := p.SuspensionPosition.decode(data[0:]); err != nil {
+		return fmt.Errorf("unable to set struct field SuspensionPosition: %v", err)
+	}
+	if err := p.SuspensionVelocity.decode(data[16:]); err != nil {
+		return fmt.Errorf("unable to set struct field SuspensionVelocity: %v", err)
+	}
+	if err := p.SuspensionAcceleration.decode(data[32:]); err != nil {
+		return fmt.Errorf("unable to set struct field SuspensionAcceleration: %v", err)
+	}
+	if err := p.WheelSpeed.decode(data[48:]); err != nil {
+		return fmt.Errorf("unable to set struct field WheelSpeed: %v", err)
+	}
+	if err := p.WheelSlip.decode(data[64:]); err != nil {
+		return fmt.Errorf("unable to set struct field WheelSlip: %v", err)
+	}
+	if err := p.LocalVelocity.decode(data[80:]); err != nil {
+		return fmt.Errorf("unable to set struct field LocalVelocity: %v", err)
+	}
+	if err := p.AngularVelocity.decode(data[92:]); err != nil {
+		return fmt.Errorf("unable to set struct field AngularVelocity: %v", err)
+	}
+	if err := p.AngularAcceleration.decode(data[104:]); err != nil {
+		return fmt.Errorf("unable to set struct field AngularAcceleration: %v", err)
+	}
+	p.FrontWheelsAngle = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[116:])))
+	return nil
+}
+
+// DecodeFrom decode Vector3 from its wire format, returning the number of bytes read
+func (p *Vector3) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 12 {
+		return 0, fmt.Errorf("unable to decode Vector3: need 12 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 12, nil
+}
+
+// PacketSize size of Vector3 on the wire in bytes
+func (p *Vector3) PacketSize() int {
+	return 12
+}
+
+// decode decode Vector3 from data holding at least 12 bytes
+func (p *Vector3) decode(data []byte) error {
+	p.X = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[0:])))
+	p.Y = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[4:])))
+	p.Z = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[8:])))
+	return nil
+}
+
+// DecodeFrom decode WheelData from its wire format, returning the number of bytes read
+func (p *WheelData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 16 {
+		return 0, fmt.Errorf("unable to decode WheelData: need 16 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 16, nil
+}
+
+// PacketSize size of WheelData on the wire in bytes
+func (p *WheelData) PacketSize() int {
+	return 16
+}
+
+// decode decode WheelData from data holding at least 16 bytes
+func (p *WheelData) decode(data []byte) error {
+	p.RearLeft = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[0:])))
+	p.RearRight = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[4:])))
+	p.FrontLeft = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[8:])))
+	p.FrontRight = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[12:])))
+	return nil
+}
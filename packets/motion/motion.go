@@ -1,7 +1,9 @@
 package motion
 
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
 import (
-	"github.com/roryphillips/f1-telemetry-client/internal/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
 )
 
 // Packet motion data
@@ -21,9 +23,9 @@ type CarMotionData struct {
 	// WorldVelocity Velocity in world space
 	WorldVelocity Vector3 `json:"world_velocity" packet:"1"`
 	// WorldForwardDir World space forward direction (normalised)
-	WorldForwardDir Vector3 `json:"world_forward_dir" packet:"2"`
+	WorldForwardDir NormalisedVector3 `json:"world_forward_dir" packet:"2"`
 	// WorldRightDir World space right direction (normalised)
-	WorldRightDir float32 `json:"world_right_dir" packet:"3"`
+	WorldRightDir NormalisedVector3 `json:"world_right_dir" packet:"3"`
 
 	// GForceLateral Lateral G-Force component
 	GForceLateral float32 `json:"g_force_lateral" packet:"4"`
@@ -75,6 +77,17 @@ type Vector3 struct {
 	Z float32 `json:"z" packet:"2"`
 }
 
+// NormalisedVector3 3-dimensional normalised vector
+// divide each dimension by 32767 to get the value between -1 and 1
+type NormalisedVector3 struct {
+	// X dimension
+	X int16 `json:"x" packet:"0"`
+	// Y dimension
+	Y int16 `json:"y" packet:"1"`
+	// Z dimension
+	Z int16 `json:"z" packet:"2"`
+}
+
 // WheelData Data that is associated with all wheels on the car
 type WheelData struct {
 	// RearLeft Rear left wheel or suspension
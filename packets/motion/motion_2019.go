@@ -0,0 +1,15 @@
+package motion
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet2019 F1 2019 motion data
+type Packet2019 struct {
+	// Header Packet header
+	Header common.Header `json:"header"`
+	// CarMotionData Data for all cars on track
+	CarMotion [20]CarMotionData `json:"car_motion" packet:"0"`
+	//PlayerCar Only available for the player
+	PlayerCar PlayerCarData `json:"player_car" packet:"1"`
+}
@@ -0,0 +1,103 @@
+package motion_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/motion"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"testing"
+)
+
+// car append the motion of car i, each field a different value derived from i
+func car(b *packettest.Builder, i int) {
+	f := float32(i * 100)
+	b.F32(f + 1).F32(f + 2).F32(f + 3)
+	b.F32(f + 4).F32(f + 5).F32(f + 6)
+	b.I16(int16(i*100 + 7)).I16(int16(i*100 + 8)).I16(int16(i*100 + 9))
+	b.I16(int16(-i*100 - 10)).I16(int16(-i*100 - 11)).I16(int16(-i*100 - 12))
+	b.F32(f + 13).F32(f + 14).F32(f + 15)
+	b.F32(f + 16).F32(f + 17).F32(f + 18)
+}
+
+// checkCar whether the motion of car i holds the values appended by car
+func checkCar(t *testing.T, name string, i int, c motion.CarMotionData) {
+	t.Helper()
+	f := float32(i * 100)
+	want := motion.CarMotionData{
+		WorldPosition:      motion.Vector3{X: f + 1, Y: f + 2, Z: f + 3},
+		WorldVelocity:      motion.Vector3{X: f + 4, Y: f + 5, Z: f + 6},
+		WorldForwardDir:    motion.NormalisedVector3{X: int16(i*100 + 7), Y: int16(i*100 + 8), Z: int16(i*100 + 9)},
+		WorldRightDir:      motion.NormalisedVector3{X: int16(-i*100 - 10), Y: int16(-i*100 - 11), Z: int16(-i*100 - 12)},
+		GForceLateral:      f + 13,
+		GForceLongitudinal: f + 14,
+		GForceVertical:     f + 15,
+		Yaw:                f + 16,
+		Pitch:              f + 17,
+		Roll:               f + 18,
+	}
+	if c != want {
+		t.Errorf("%v: car %v is %+v, want %+v", name, i, c, want)
+	}
+}
+
+// playerCar append the extra motion data of the player's car
+func playerCar(b *packettest.Builder) {
+	for i := 0; i < 5; i++ {
+		base := float32(i*4 + 1)
+		b.F32(base).F32(base + 1).F32(base + 2).F32(base + 3)
+	}
+	b.F32(21).F32(22).F32(23)
+	b.F32(24).F32(25).F32(26)
+	b.F32(27).F32(28).F32(29)
+	b.F32(-0.5)
+}
+
+// checkPlayerCar whether the player car data holds the values appended by playerCar
+func checkPlayerCar(t *testing.T, name string, p motion.PlayerCarData) {
+	t.Helper()
+	want := motion.PlayerCarData{
+		SuspensionPosition:     motion.WheelData{RearLeft: 1, RearRight: 2, FrontLeft: 3, FrontRight: 4},
+		SuspensionVelocity:     motion.WheelData{RearLeft: 5, RearRight: 6, FrontLeft: 7, FrontRight: 8},
+		SuspensionAcceleration: motion.WheelData{RearLeft: 9, RearRight: 10, FrontLeft: 11, FrontRight: 12},
+		WheelSpeed:             motion.WheelData{RearLeft: 13, RearRight: 14, FrontLeft: 15, FrontRight: 16},
+		WheelSlip:              motion.WheelData{RearLeft: 17, RearRight: 18, FrontLeft: 19, FrontRight: 20},
+		LocalVelocity:          motion.Vector3{X: 21, Y: 22, Z: 23},
+		AngularVelocity:        motion.Vector3{X: 24, Y: 25, Z: 26},
+		AngularAcceleration:    motion.Vector3{X: 27, Y: 28, Z: 29},
+		FrontWheelsAngle:       -0.5,
+	}
+	if p != want {
+		t.Errorf("%v: player car is %+v, want %+v", name, p, want)
+	}
+}
+
+func TestGolden2019(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2019, common.PacketIDMotion)
+	b := &packettest.Builder{}
+	for i := 0; i < 20; i++ {
+		car(b, i)
+	}
+	playerCar(b)
+
+	packet := packettest.Golden(t, layout, b.Bytes()).(*motion.Packet2019)
+	for i, c := range packet.CarMotion {
+		checkCar(t, layout.Name, i, c)
+	}
+	checkPlayerCar(t, layout.Name, packet.PlayerCar)
+}
+
+func TestGolden(t *testing.T) {
+	for _, format := range []uint16{common.PacketFormat2020, common.PacketFormat2021, common.PacketFormat2022} {
+		layout := packettest.LayoutOf(format, common.PacketIDMotion)
+		b := &packettest.Builder{}
+		for i := 0; i < 22; i++ {
+			car(b, i)
+		}
+		playerCar(b)
+
+		packet := packettest.Golden(t, layout, b.Bytes()).(*motion.Packet)
+		for i, c := range packet.CarMotion {
+			checkCar(t, layout.Name, i, c)
+		}
+		checkPlayerCar(t, layout.Name, packet.PlayerCar)
+	}
+}
@@ -0,0 +1,152 @@
+package packets
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Packet reads little endian values in order from the raw bytes of a packet
+type Packet interface {
+	Bool() (bool, error)
+	Float() (float32, error)
+	Double() (float64, error)
+	UInt64() (uint64, error)
+	UInt32() (uint32, error)
+	UInt16() (uint16, error)
+	UInt8() (uint8, error)
+	Int64() (int64, error)
+	Int32() (int32, error)
+	Int16() (int16, error)
+	Int8() (int8, error)
+
+	// Offset number of bytes read so far
+	Offset() int
+	// Remaining number of bytes left to read
+	Remaining() int
+	// Skip over the next n bytes
+	Skip(n int) error
+	// Bytes the next n bytes, sharing memory with the packet data
+	Bytes(n int) ([]byte, error)
+}
+
+// NewPacket creates a packet reading directly from the data, without copying it
+func NewPacket(data []byte) Packet {
+	return &packet{
+		data: data,
+	}
+}
+
+type packet struct {
+	data   []byte
+	offset int
+}
+
+// next consume the next n bytes, failing without consuming any if there are too few left
+func (p *packet) next(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("unable to read %v bytes at offset %v", n, p.offset)
+	}
+	if p.Remaining() < n {
+		return nil, fmt.Errorf("unable to read %v bytes at offset %v: only %v remaining", n, p.offset, p.Remaining())
+	}
+	b := p.data[p.offset : p.offset+n]
+	p.offset += n
+	return b, nil
+}
+
+func (p *packet) Offset() int {
+	return p.offset
+}
+
+func (p *packet) Remaining() int {
+	return len(p.data) - p.offset
+}
+
+func (p *packet) Skip(n int) error {
+	_, err := p.next(n)
+	return err
+}
+
+func (p *packet) Bytes(n int) ([]byte, error) {
+	return p.next(n)
+}
+
+func (p *packet) Bool() (bool, error) {
+	b, err := p.next(1)
+	if err != nil {
+		return false, err
+	}
+	if b[0] > 1 {
+		return false, fmt.Errorf("unexpected byte value %v at offset %v is <0 or >1", b[0], p.offset-1)
+	}
+	return b[0] == 1, nil
+}
+
+func (p *packet) Float() (float32, error) {
+	b, err := p.next(4)
+	if err != nil {
+		return 0, err
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+}
+
+func (p *packet) Double() (float64, error) {
+	b, err := p.next(8)
+	if err != nil {
+		return 0, err
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+}
+
+func (p *packet) UInt64() (uint64, error) {
+	b, err := p.next(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+func (p *packet) UInt32() (uint32, error) {
+	b, err := p.next(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (p *packet) UInt16() (uint16, error) {
+	b, err := p.next(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (p *packet) UInt8() (uint8, error) {
+	b, err := p.next(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (p *packet) Int64() (int64, error) {
+	v, err := p.UInt64()
+	return int64(v), err
+}
+
+func (p *packet) Int32() (int32, error) {
+	v, err := p.UInt32()
+	return int32(v), err
+}
+
+func (p *packet) Int16() (int16, error) {
+	v, err := p.UInt16()
+	return int16(v), err
+}
+
+func (p *packet) Int8() (int8, error) {
+	v, err := p.UInt8()
+	return int8(v), err
+}
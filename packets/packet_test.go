@@ -0,0 +1,202 @@
+package packets_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets"
+	"strings"
+	"testing"
+)
+
+func TestPacketRead(t *testing.T) {
+	data := []byte{
+		0x01,
+		0xfe,
+		0x34, 0x12,
+		0x78, 0x56, 0x34, 0x12,
+		0x00, 0x00, 0x80, 0x3f,
+		0xef, 0xcd, 0xab, 0x89, 0x67, 0x45, 0x23, 0x01,
+	}
+	p := packets.NewPacket(data)
+
+	b, err := p.Bool()
+	if err != nil || !b {
+		t.Errorf("Bool() = %v, %v, want true", b, err)
+	}
+	i8, err := p.Int8()
+	if err != nil || i8 != -2 {
+		t.Errorf("Int8() = %v, %v, want -2", i8, err)
+	}
+	u16, err := p.UInt16()
+	if err != nil || u16 != 0x1234 {
+		t.Errorf("UInt16() = %#x, %v, want 0x1234", u16, err)
+	}
+	u32, err := p.UInt32()
+	if err != nil || u32 != 0x12345678 {
+		t.Errorf("UInt32() = %#x, %v, want 0x12345678", u32, err)
+	}
+	f, err := p.Float()
+	if err != nil || f != 1 {
+		t.Errorf("Float() = %v, %v, want 1", f, err)
+	}
+	u64, err := p.UInt64()
+	if err != nil || u64 != 0x0123456789abcdef {
+		t.Errorf("UInt64() = %#x, %v, want 0x0123456789abcdef", u64, err)
+	}
+	if p.Offset() != len(data) || p.Remaining() != 0 {
+		t.Errorf("read every byte, offset %v and %v remaining", p.Offset(), p.Remaining())
+	}
+}
+
+func TestPacketErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		// size of the packet
+		size int
+		// skip bytes read before the failing read
+		skip int
+		read func(p packets.Packet) error
+		// want text the error must contain
+		want string
+		// consumed bytes consumed by the failing read, only a bool which was read but isn't 0 or 1
+		consumed int
+	}{
+		{
+			name: "uint8 at end",
+			size: 4, skip: 4,
+			read: func(p packets.Packet) error { _, err := p.UInt8(); return err },
+			want: "unable to read 1 bytes at offset 4: only 0 remaining",
+		},
+		{
+			name: "uint16 one short",
+			size: 5, skip: 4,
+			read: func(p packets.Packet) error { _, err := p.UInt16(); return err },
+			want: "unable to read 2 bytes at offset 4: only 1 remaining",
+		},
+		{
+			name: "uint32 past end",
+			size: 10, skip: 7,
+			read: func(p packets.Packet) error { _, err := p.UInt32(); return err },
+			want: "unable to read 4 bytes at offset 7: only 3 remaining",
+		},
+		{
+			name: "float past end",
+			size: 3,
+			read: func(p packets.Packet) error { _, err := p.Float(); return err },
+			want: "unable to read 4 bytes at offset 0: only 3 remaining",
+		},
+		{
+			name: "uint64 past end",
+			size: 20, skip: 13,
+			read: func(p packets.Packet) error { _, err := p.UInt64(); return err },
+			want: "unable to read 8 bytes at offset 13: only 7 remaining",
+		},
+		{
+			name: "double past end",
+			size: 8, skip: 1,
+			read: func(p packets.Packet) error { _, err := p.Double(); return err },
+			want: "unable to read 8 bytes at offset 1: only 7 remaining",
+		},
+		{
+			name: "int16 past end",
+			size: 1,
+			read: func(p packets.Packet) error { _, err := p.Int16(); return err },
+			want: "unable to read 2 bytes at offset 0: only 1 remaining",
+		},
+		{
+			name: "bool not 0 or 1",
+			size: 4, skip: 2,
+			read:     func(p packets.Packet) error { _, err := p.Bool(); return err },
+			want:     "unexpected byte value 255 at offset 2",
+			consumed: 1,
+		},
+		{
+			name: "skip past end",
+			size: 10, skip: 6,
+			read: func(p packets.Packet) error { return p.Skip(5) },
+			want: "unable to read 5 bytes at offset 6: only 4 remaining",
+		},
+		{
+			name: "skip backwards",
+			size: 10, skip: 6,
+			read: func(p packets.Packet) error { return p.Skip(-1) },
+			want: "unable to read -1 bytes at offset 6",
+		},
+		{
+			name: "bytes past end",
+			size: 48, skip: 40,
+			read: func(p packets.Packet) error { _, err := p.Bytes(9); return err },
+			want: "unable to read 9 bytes at offset 40: only 8 remaining",
+		},
+	}
+
+	for _, test := range tests {
+		data := make([]byte, test.size)
+		for i := range data {
+			data[i] = 0xff
+		}
+		p := packets.NewPacket(data)
+		err := p.Skip(test.skip)
+		if err != nil {
+			t.Fatalf("%v: failed to skip %v bytes: %v", test.name, test.skip, err)
+		}
+
+		err = test.read(p)
+		if err == nil {
+			t.Errorf("%v: read past the end without an error", test.name)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%v: returned %q, want it to contain %q", test.name, err, test.want)
+		}
+		// A read past the end consumes nothing, so the offset in the error is where reading stopped
+		offset := test.skip + test.consumed
+		if p.Offset() != offset || p.Remaining() != test.size-offset {
+			t.Errorf("%v: offset %v and %v remaining after failing, want %v and %v", test.name, p.Offset(), p.Remaining(), offset, test.size-offset)
+		}
+	}
+}
+
+func TestPacketSkipAndBytes(t *testing.T) {
+	data := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	p := packets.NewPacket(data)
+
+	err := p.Skip(3)
+	if err != nil {
+		t.Fatalf("failed to skip: %v", err)
+	}
+	if p.Offset() != 3 || p.Remaining() != 7 {
+		t.Errorf("offset %v and %v remaining after skipping 3 bytes", p.Offset(), p.Remaining())
+	}
+
+	b, err := p.Bytes(4)
+	if err != nil {
+		t.Fatalf("failed to read bytes: %v", err)
+	}
+	if len(b) != 4 || b[0] != 3 || b[3] != 6 {
+		t.Errorf("Bytes(4) at offset 3 = %v, want [3 4 5 6]", b)
+	}
+	if p.Offset() != 7 {
+		t.Errorf("offset %v after reading 4 bytes from 3", p.Offset())
+	}
+	// The bytes are a subslice of the packet data rather than a copy
+	b[0] = 42
+	if data[3] != 42 {
+		t.Errorf("writing to the bytes read didn't change the packet data, they were copied")
+	}
+	if &b[0] != &data[3] {
+		t.Errorf("bytes read don't share memory with the packet data")
+	}
+
+	// Reading up to the end, and nothing once there, is fine
+	b, err = p.Bytes(3)
+	if err != nil || len(b) != 3 {
+		t.Errorf("Bytes(3) with 3 remaining = %v, %v", b, err)
+	}
+	b, err = p.Bytes(0)
+	if err != nil || len(b) != 0 {
+		t.Errorf("Bytes(0) at the end = %v, %v", b, err)
+	}
+	err = p.Skip(0)
+	if err != nil {
+		t.Errorf("Skip(0) at the end = %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package packettest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/roryphillips/f1-telemetry-client/packets"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"math"
+	"testing"
+)
+
+// Builder builds a packet body by hand, field by field in the order documented for the game,
+// so golden fixtures don't depend on the struct tags they test
+type Builder struct {
+	data []byte
+}
+
+// U8 append a uint8
+func (b *Builder) U8(v uint8) *Builder {
+	b.data = append(b.data, v)
+	return b
+}
+
+// I8 append an int8
+func (b *Builder) I8(v int8) *Builder {
+	return b.U8(uint8(v))
+}
+
+// Bool append a bool as a single byte
+func (b *Builder) Bool(v bool) *Builder {
+	if v {
+		return b.U8(1)
+	}
+	return b.U8(0)
+}
+
+// U16 append a little endian uint16
+func (b *Builder) U16(v uint16) *Builder {
+	b.data = append(b.data, byte(v), byte(v>>8))
+	return b
+}
+
+// I16 append a little endian int16
+func (b *Builder) I16(v int16) *Builder {
+	return b.U16(uint16(v))
+}
+
+// U32 append a little endian uint32
+func (b *Builder) U32(v uint32) *Builder {
+	b.data = append(b.data, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(b.data[len(b.data)-4:], v)
+	return b
+}
+
+// U64 append a little endian uint64
+func (b *Builder) U64(v uint64) *Builder {
+	b.data = append(b.data, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint64(b.data[len(b.data)-8:], v)
+	return b
+}
+
+// F32 append a little endian float32
+func (b *Builder) F32(v float32) *Builder {
+	return b.U32(math.Float32bits(v))
+}
+
+// F64 append a little endian float64
+func (b *Builder) F64(v float64) *Builder {
+	return b.U64(math.Float64bits(v))
+}
+
+// String append a string padded with zeros to a fixed length
+func (b *Builder) String(s string, length int) *Builder {
+	field := make([]byte, length)
+	copy(field, s)
+	b.data = append(b.data, field...)
+	return b
+}
+
+// Zeros append n zero bytes
+func (b *Builder) Zeros(n int) *Builder {
+	b.data = append(b.data, make([]byte, n)...)
+	return b
+}
+
+// Len number of bytes built so far
+func (b *Builder) Len() int {
+	return len(b.data)
+}
+
+// Bytes the body built so far
+func (b *Builder) Bytes() []byte {
+	return b.data
+}
+
+// Golden decode a hand built body of a layout with both its generated decoder and the reflective parser,
+// failing unless the body is the size of the layout, both read all of it into equal packets, and the packet
+// encodes back into the same bytes. Returns the decoded packet for its fields to be checked by name.
+func Golden(t *testing.T, layout Layout, data []byte) interface{} {
+	t.Helper()
+	if len(data) != layout.BodySize() {
+		t.Fatalf("%v: golden body is %v bytes, want %v", layout.Name, len(data), layout.BodySize())
+	}
+	header := common.Header{PacketFormat: layout.Format}
+
+	generated := layout.New(header)
+	decoder, ok := generated.(packets.PacketDecoder)
+	if !ok {
+		t.Fatalf("%v: %T has no generated decoder", layout.Name, generated)
+	}
+	n, err := decoder.DecodeFrom(data)
+	if err != nil {
+		t.Fatalf("%v: failed to decode golden body: %v", layout.Name, err)
+	}
+	if n != len(data) && !layout.Padded {
+		t.Errorf("%v: generated decoder read %v bytes, want %v", layout.Name, n, len(data))
+	}
+
+	reflective := layout.New(header)
+	packet := packets.NewPacket(data)
+	err = packets.NewPacketParser().Parse(packet, reflective)
+	if err != nil {
+		t.Fatalf("%v: failed to parse golden body: %v", layout.Name, err)
+	}
+	if packet.Offset() != n {
+		t.Errorf("%v: reflective parser read %v bytes, generated decoder read %v", layout.Name, packet.Offset(), n)
+	}
+	if !Equal(generated, reflective) {
+		t.Errorf("%v: generated decoder gave %+v, reflective parser gave %+v", layout.Name, generated, reflective)
+	}
+
+	encoded, err := packets.NewPacketWriter().Encode(generated)
+	if err != nil {
+		t.Fatalf("%v: failed to encode golden packet: %v", layout.Name, err)
+	}
+	if !bytes.Equal(encoded, data) {
+		t.Errorf("%v: golden packet encoded to %x, want %x", layout.Name, encoded, data)
+	}
+	return generated
+}
@@ -0,0 +1,130 @@
+package packettest
+
+import (
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/event"
+	"github.com/roryphillips/f1-telemetry-client/registry"
+)
+
+// sizes the size on the wire of every packet type the default registry decodes, including the header,
+// taken from the game's documentation
+var sizes = []struct {
+	format uint16
+	id     common.PacketID
+	size   int
+}{
+	{common.PacketFormat2019, common.PacketIDMotion, 1343},
+	{common.PacketFormat2019, common.PacketIDSession, 149},
+	{common.PacketFormat2019, common.PacketIDLapData, 843},
+	{common.PacketFormat2019, common.PacketIDEvent, 32},
+	{common.PacketFormat2019, common.PacketIDParticipants, 1104},
+	{common.PacketFormat2019, common.PacketIDCarSetups, 843},
+	{common.PacketFormat2019, common.PacketIDCarTelemetry, 1347},
+	{common.PacketFormat2019, common.PacketIDCarStatus, 1143},
+
+	{common.PacketFormat2020, common.PacketIDMotion, 1464},
+	{common.PacketFormat2020, common.PacketIDSession, 251},
+	{common.PacketFormat2020, common.PacketIDLapData, 1190},
+	{common.PacketFormat2020, common.PacketIDEvent, 35},
+	{common.PacketFormat2020, common.PacketIDParticipants, 1213},
+	{common.PacketFormat2020, common.PacketIDCarSetups, 1102},
+	{common.PacketFormat2020, common.PacketIDCarTelemetry, 1307},
+	{common.PacketFormat2020, common.PacketIDCarStatus, 1344},
+	{common.PacketFormat2020, common.PacketIDFinalClassification, 839},
+	{common.PacketFormat2020, common.PacketIDLobbyInfo, 1169},
+
+	{common.PacketFormat2021, common.PacketIDMotion, 1464},
+	{common.PacketFormat2021, common.PacketIDSession, 625},
+	{common.PacketFormat2021, common.PacketIDLapData, 970},
+	{common.PacketFormat2021, common.PacketIDEvent, 36},
+	{common.PacketFormat2021, common.PacketIDParticipants, 1257},
+	{common.PacketFormat2021, common.PacketIDCarSetups, 1102},
+	{common.PacketFormat2021, common.PacketIDCarTelemetry, 1347},
+	{common.PacketFormat2021, common.PacketIDCarStatus, 1058},
+	{common.PacketFormat2021, common.PacketIDFinalClassification, 839},
+	{common.PacketFormat2021, common.PacketIDLobbyInfo, 1191},
+	{common.PacketFormat2021, common.PacketIDCarDamage, 882},
+	{common.PacketFormat2021, common.PacketIDSessionHistory, 1155},
+
+	{common.PacketFormat2022, common.PacketIDMotion, 1464},
+	{common.PacketFormat2022, common.PacketIDSession, 632},
+	{common.PacketFormat2022, common.PacketIDLapData, 972},
+	{common.PacketFormat2022, common.PacketIDEvent, 40},
+	{common.PacketFormat2022, common.PacketIDParticipants, 1257},
+	{common.PacketFormat2022, common.PacketIDCarSetups, 1102},
+	{common.PacketFormat2022, common.PacketIDCarTelemetry, 1347},
+	{common.PacketFormat2022, common.PacketIDCarStatus, 1058},
+	{common.PacketFormat2022, common.PacketIDFinalClassification, 1015},
+	{common.PacketFormat2022, common.PacketIDLobbyInfo, 1191},
+	{common.PacketFormat2022, common.PacketIDCarDamage, 948},
+	{common.PacketFormat2022, common.PacketIDSessionHistory, 1155},
+}
+
+// EventCodes every event code with the first packet format it's sent in, as the details decoded depend on it
+var EventCodes = []struct {
+	Code  event.EventCode
+	Since uint16
+}{
+	{event.EventCodeSessionStarted, common.PacketFormat2019},
+	{event.EventCodeSessionEnded, common.PacketFormat2019},
+	{event.EventCodeFastestLap, common.PacketFormat2019},
+	{event.EventCodeRetirement, common.PacketFormat2019},
+	{event.EventCodeDRSEnabled, common.PacketFormat2019},
+	{event.EventCodeDRSDisabled, common.PacketFormat2019},
+	{event.EventCodeTeamMateInPits, common.PacketFormat2019},
+	{event.EventCodeChequeredFlag, common.PacketFormat2019},
+	{event.EventCodeRaceWinner, common.PacketFormat2019},
+	{event.EventCodePenalty, common.PacketFormat2020},
+	{event.EventCodeSpeedTrap, common.PacketFormat2020},
+	{event.EventCodeStartLights, common.PacketFormat2021},
+	{event.EventCodeLightsOut, common.PacketFormat2021},
+	{event.EventCodeDriveThroughServed, common.PacketFormat2021},
+	{event.EventCodeStopGoServed, common.PacketFormat2021},
+	{event.EventCodeFlashback, common.PacketFormat2021},
+	{event.EventCodeButtonStatus, common.PacketFormat2021},
+}
+
+// Layouts every layout registered in the default registry, with the size of real packets.
+// Event layouts have fixtures for every event code sent in their format.
+func Layouts() []Layout {
+	layouts := make([]Layout, 0, len(sizes))
+	for _, s := range sizes {
+		layouts = append(layouts, LayoutOf(s.format, s.id))
+	}
+	return layouts
+}
+
+// LayoutOf the layout registered in the default registry for a packet type in a packet format,
+// panics if there isn't one as the table of sizes is out of date
+func LayoutOf(format uint16, id common.PacketID) Layout {
+	for _, s := range sizes {
+		if s.format != format || s.id != id {
+			continue
+		}
+		entry, ok := registry.Lookup(common.Header{PacketFormat: format, PacketID: id, PacketVersion: 1})
+		if !ok {
+			panic(fmt.Sprintf("no %v packet type %v is registered", format, id))
+		}
+		l := Layout{
+			Name:   fmt.Sprintf("%v %v", format, entry.Name),
+			Format: format,
+			Size:   s.size,
+			New:    entry.New,
+		}
+		if id == common.PacketIDEvent {
+			l.Padded = true
+			for _, c := range EventCodes {
+				if c.Since > format {
+					continue
+				}
+				for _, fixture := range Fixtures(l.BodySize()) {
+					copy(fixture.Data, c.Code)
+					l.Fixtures = append(l.Fixtures, fixture)
+				}
+			}
+		}
+		return l
+	}
+	panic(fmt.Sprintf("no size is known for %v packet type %v", format, id))
+}
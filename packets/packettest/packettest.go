@@ -0,0 +1,225 @@
+// Package packettest helpers for testing packet layouts against real sized packets
+package packettest
+
+import (
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// Layout a packet layout as registered for a packet format
+type Layout struct {
+	// Name of the layout in test output, i.e. "2021"
+	Name string
+	// Format packet format the layout is registered for
+	Format uint16
+	// Size of the whole packet on the wire in bytes, including the header
+	Size int
+	// New creates an empty packet of the layout, as a ptr to a struct, for a header
+	New func(header common.Header) interface{}
+	// Fixtures packet bodies to test with, defaults to Fixtures of the body size
+	Fixtures []Fixture
+	// Padded the body is padded to its size, so packets may be read from fewer bytes, i.e. event details
+	Padded bool
+}
+
+// BodySize size of the packet on the wire in bytes after the header
+func (l Layout) BodySize() int {
+	switch {
+	case l.Format <= common.PacketFormat2019:
+		return l.Size - (&common.Header2019{}).PacketSize()
+	case l.Format >= common.PacketFormat2023:
+		return l.Size - (&common.Header2023{}).PacketSize()
+	}
+	return l.Size - (&common.Header{}).PacketSize()
+}
+
+// fixtures the layout's fixtures, or the default fixtures for its size
+func (l Layout) fixtures() []Fixture {
+	if l.Fixtures != nil {
+		return l.Fixtures
+	}
+	return Fixtures(l.BodySize())
+}
+
+// Fixture the body of a packet, following the header
+type Fixture struct {
+	// Data raw bytes of the body
+	Data []byte
+	// Valid every layout of the size can decode the data, i.e. every bool is 0 or 1
+	Valid bool
+}
+
+// Fixtures packet bodies of the given size: all zeros, random zeros and ones which are valid for every layout,
+// and random bytes which often aren't. The same size always gives the same fixtures.
+func Fixtures(size int) []Fixture {
+	random := rand.New(rand.NewSource(int64(size)))
+	fixtures := []Fixture{{Data: make([]byte, size), Valid: true}}
+	for i := 0; i < 4; i++ {
+		data := make([]byte, size)
+		for j := range data {
+			data[j] = byte(random.Intn(2))
+		}
+		fixtures = append(fixtures, Fixture{Data: data, Valid: true})
+	}
+	for i := 0; i < 4; i++ {
+		data := make([]byte, size)
+		random.Read(data)
+		fixtures = append(fixtures, Fixture{Data: data})
+	}
+	return fixtures
+}
+
+// CompareDecoders decode the fixtures of each layout with both its generated decoder and the reflective parser,
+// failing unless both read the whole body into equal packets, or both fail
+func CompareDecoders(t *testing.T, layouts []Layout) {
+	t.Helper()
+	parser := packets.NewPacketParser()
+	for _, layout := range layouts {
+		layout := layout
+		t.Run(layout.Name, func(t *testing.T) {
+			header := common.Header{PacketFormat: layout.Format}
+			size := layout.BodySize()
+			for i, fixture := range layout.fixtures() {
+				generated := layout.New(header)
+				decoder, ok := generated.(packets.PacketDecoder)
+				if !ok {
+					t.Fatalf("%T has no generated decoder", generated)
+				}
+				n, generatedErr := decoder.DecodeFrom(fixture.Data)
+
+				reflective := layout.New(header)
+				data := packets.NewPacket(fixture.Data)
+				reflectiveErr := parser.Parse(data, reflective)
+
+				if (generatedErr == nil) != (reflectiveErr == nil) {
+					t.Errorf("fixture %v: generated decoder returned %v, reflective parser returned %v", i, generatedErr, reflectiveErr)
+					continue
+				}
+				if generatedErr != nil {
+					if fixture.Valid {
+						t.Errorf("fixture %v: failed to decode: %v", i, generatedErr)
+					}
+					continue
+				}
+				if n != data.Offset() || n > size || (n != size && !layout.Padded) {
+					t.Errorf("fixture %v: generated decoder read %v bytes, reflective parser read %v, want %v", i, n, data.Offset(), size)
+				}
+				if !Equal(generated, reflective) {
+					t.Errorf("fixture %v: generated decoder gave %+v, reflective parser gave %+v", i, generated, reflective)
+				}
+			}
+		})
+	}
+}
+
+// RoundTrip decode the valid fixtures of each layout, then encode the packets and decode them again,
+// failing unless the encoded packets are the size of the body and decode back into equal packets
+func RoundTrip(t *testing.T, layouts []Layout) {
+	t.Helper()
+	writer := packets.NewPacketWriter()
+	for _, layout := range layouts {
+		layout := layout
+		t.Run(layout.Name, func(t *testing.T) {
+			header := common.Header{PacketFormat: layout.Format}
+			size := layout.BodySize()
+			for i, fixture := range layout.fixtures() {
+				if !fixture.Valid {
+					continue
+				}
+				decoded, err := decode(layout.New(header), fixture.Data)
+				if err != nil {
+					t.Errorf("fixture %v: failed to decode: %v", i, err)
+					continue
+				}
+
+				encoded, err := writer.Encode(decoded)
+				if err != nil {
+					t.Errorf("fixture %v: failed to encode: %v", i, err)
+					continue
+				}
+				if len(encoded) != size {
+					t.Errorf("fixture %v: encoded %v bytes, want %v", i, len(encoded), size)
+				}
+
+				redecoded, err := decode(layout.New(header), encoded)
+				if err != nil {
+					t.Errorf("fixture %v: failed to decode encoded packet: %v", i, err)
+					continue
+				}
+				if !Equal(decoded, redecoded) {
+					t.Errorf("fixture %v: decoded %+v, after encoding decoded %+v", i, decoded, redecoded)
+				}
+			}
+		})
+	}
+}
+
+// decode data into a packet with its generated decoder
+func decode(packet interface{}, data []byte) (interface{}, error) {
+	decoder, ok := packet.(packets.PacketDecoder)
+	if !ok {
+		return nil, fmt.Errorf("%T has no generated decoder", packet)
+	}
+	_, err := decoder.DecodeFrom(data)
+	return packet, err
+}
+
+// Equal whether two packets are deeply equal, treating NaN as equal to NaN so packets decoded
+// from the same random bytes compare equal
+func Equal(a interface{}, b interface{}) bool {
+	return equal(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func equal(a reflect.Value, b reflect.Value) bool {
+	if a.IsValid() != b.IsValid() {
+		return false
+	}
+	if !a.IsValid() {
+		return true
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return equal(a.Elem(), b.Elem())
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !equal(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Array, reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !equal(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Float32, reflect.Float64:
+		x, y := a.Float(), b.Float()
+		return x == y || (math.IsNaN(x) && math.IsNaN(y))
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() == b.Uint()
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
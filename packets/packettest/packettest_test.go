@@ -0,0 +1,14 @@
+package packettest_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	packettest.RoundTrip(t, packettest.Layouts())
+}
+
+func TestDecodersMatch(t *testing.T) {
+	packettest.CompareDecoders(t, packettest.Layouts())
+}
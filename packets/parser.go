@@ -1,6 +1,7 @@
-package internal
+package packets
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 	"sort"
@@ -13,6 +14,18 @@ type PacketParser interface {
 	Parse(data Packet, dest interface{}) error
 }
 
+// PacketUnmarshaler implemented by types which cannot be described by packet tags alone,
+// such as packets whose layout depends on a value read earlier in the packet
+type PacketUnmarshaler interface {
+	UnmarshalPacket(parser PacketParser, data Packet) error
+}
+
+// PacketDecoder implemented by packets with a reflection free decoder, generated by packet-decoder-gen
+type PacketDecoder interface {
+	// DecodeFrom decode from the wire format, returning the number of bytes read
+	DecodeFrom(data []byte) (int, error)
+}
+
 type packetParser struct {
 	reflections map[string][]packetField
 	lock *sync.Mutex
@@ -21,6 +34,8 @@ type packetParser struct {
 type packetField struct {
 	packetIdx int
 	name      string
+	// length number of bytes to read for fixed length fields such as strings
+	length int
 }
 
 // NewPacketParser creates a new packet parser
@@ -33,6 +48,10 @@ func NewPacketParser() PacketParser {
 
 // Parse taking a packet and a ptr to a struct to load the data into
 func (p *packetParser) Parse(data Packet, dest interface{}) error {
+	if unmarshaler, ok := dest.(PacketUnmarshaler); ok {
+		return unmarshaler.UnmarshalPacket(p, data)
+	}
+
 	val := reflect.Indirect(reflect.ValueOf(dest))
 	if val.Kind() != reflect.Struct {
 		return fmt.Errorf("must pass a struct type into the reflection parser")
@@ -45,7 +64,7 @@ func (p *packetParser) Parse(data Packet, dest interface{}) error {
 
 	for _, field := range fields {
 		f := val.FieldByName(field.name)
-		err = p.setVal(f, data)
+		err = p.setVal(f, field, data)
 		if err != nil {
 			return fmt.Errorf("failed to set %v value: %v", field.name, err)
 		}
@@ -78,7 +97,7 @@ func (p *packetParser) inspect(val reflect.Value) ([]packetField, error) {
 }
 
 // setVal read the value from the packet for a given field
-func (p *packetParser) setVal(f reflect.Value, data Packet) error {
+func (p *packetParser) setVal(f reflect.Value, field packetField, data Packet) error {
 	if !f.CanSet() {
 		return fmt.Errorf("field is not settable")
 	}
@@ -88,11 +107,15 @@ func (p *packetParser) setVal(f reflect.Value, data Packet) error {
 	case reflect.Struct:
 		return p.parseStruct(f, data)
 	case reflect.Array:
-		return p.parseArray(f, data)
+		return p.parseArray(f, field, data)
+	case reflect.String:
+		return p.parseString(f, field.length, data)
 	case reflect.Bool:
 		return p.parseBool(f, data)
 	case reflect.Float32:
 		return p.parseFloat32(f, data)
+	case reflect.Float64:
+		return p.parseFloat64(f, data)
 	case reflect.Uint64:
 		return p.parseUint64(f, data)
 	case reflect.Uint32:
@@ -122,7 +145,7 @@ func (p *packetParser) parseStruct(f reflect.Value, d Packet) error {
 	}
 	for _, field := range fields {
 		structField := structVal.FieldByName(field.name)
-		err := p.setVal(structField, d)
+		err := p.setVal(structField, field, d)
 		if err != nil {
 			return fmt.Errorf("unable to set struct field %v: %v", field.name, err)
 		}
@@ -132,19 +155,32 @@ func (p *packetParser) parseStruct(f reflect.Value, d Packet) error {
 	return nil
 }
 
-// parseArray parse an array out of the packet stream
-func (p *packetParser) parseArray(f reflect.Value, d Packet) error {
+// parseArray parse an array out of the packet stream, each item is parsed as the field itself
+// so arrays of strings share the field length
+func (p *packetParser) parseArray(f reflect.Value, field packetField, d Packet) error {
 	size := f.Type().Len()
 	for i := 0; i < size; i += 1 {
-		idxVal := f.Index(i)
-
-		err := p.parseStruct(idxVal, d)
+		err := p.setVal(f.Index(i), field, d)
 		if err != nil {
 			return fmt.Errorf("unable to parse array item %v: %v", i, err)
 		}
+	}
+	return nil
+}
 
-		f.Index(i).Set(idxVal)
+// parseString parse a fixed length, null terminated UTF-8 string out of the packet stream
+func (p *packetParser) parseString(f reflect.Value, length int, d Packet) error {
+	if length <= 0 {
+		return fmt.Errorf("string fields must specify a length")
 	}
+	buf, err := d.Bytes(length)
+	if err != nil {
+		return fmt.Errorf("unable to parse string: %v", err)
+	}
+	if end := bytes.IndexByte(buf, 0); end >= 0 {
+		buf = buf[:end]
+	}
+	f.SetString(string(buf))
 	return nil
 }
 
@@ -168,6 +204,16 @@ func (p *packetParser) parseFloat32(f reflect.Value, d Packet) error {
 	return nil
 }
 
+// parseFloat64 parse an float64 out of the packet stream
+func (p *packetParser) parseFloat64(f reflect.Value, d Packet) error {
+	val, err := d.Double()
+	if err != nil {
+		return fmt.Errorf("unable to parse double: %v", err)
+	}
+	f.SetFloat(val)
+	return nil
+}
+
 // parseUint64 parse an uint64 out of the packet stream
 func (p *packetParser) parseUint64(f reflect.Value, d Packet) error {
 	val, err := d.UInt64()
@@ -260,10 +306,19 @@ func generateSortedFields(v reflect.Value) ([]packetField, error) {
 			if err != nil {
 				return fields, fmt.Errorf("invalid packet index received: %v", err)
 			}
-			fields = append(fields, packetField{
+			field := packetField{
 				packetIdx: int(pInt),
 				name:      f.Name,
-			})
+			}
+			length := f.Tag.Get("length")
+			if length != "" {
+				lInt, err := strconv.ParseInt(length, 10, 32)
+				if err != nil {
+					return fields, fmt.Errorf("invalid length received: %v", err)
+				}
+				field.length = int(lInt)
+			}
+			fields = append(fields, field)
 		}
 	}
 
@@ -0,0 +1,170 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package participants
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1189 {
+		return 0, fmt.Errorf("unable to decode Packet: need 1189 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1189, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 1189
+}
+
+// decode decode Packet from data holding at least 1189 bytes
+func (p *Packet) decode(data []byte) error {
+	p.NumActiveCars = uint8(data[0])
+	for i0 := range p.Participants {
+		if err := p.Participants[i0].decode(data[1+i0*54:]); err != nil {
+			return fmt.Errorf("unable to set struct field Participants item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2019 from its wire format, returning the number of bytes read
+func (p *Packet2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1081 {
+		return 0, fmt.Errorf("unable to decode Packet2019: need 1081 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1081, nil
+}
+
+// PacketSize size of Packet2019 on the wire in bytes
+func (p *Packet2019) PacketSize() int {
+	return 1081
+}
+
+// decode decode Packet2019 from data holding at least 1081 bytes
+func (p *Packet2019) decode(data []byte) error {
+	p.NumActiveCars = uint8(data[0])
+	for i0 := range p.Participants {
+		if err := p.Participants[i0].decode(data[1+i0*54:]); err != nil {
+			return fmt.Errorf("unable to set struct field Participants item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2021 from its wire format, returning the number of bytes read
+func (p *Packet2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1233 {
+		return 0, fmt.Errorf("unable to decode Packet2021: need 1233 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1233, nil
+}
+
+// PacketSize size of Packet2021 on the wire in bytes
+func (p *Packet2021) PacketSize() int {
+	return 1233
+}
+
+// decode decode Packet2021 from data holding at least 1233 bytes
+func (p *Packet2021) decode(data []byte) error {
+	p.NumActiveCars = uint8(data[0])
+	for i0 := range p.Participants {
+		if err := p.Participants[i0].decode(data[1+i0*56:]); err != nil {
+			return fmt.Errorf("unable to set struct field Participants item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode ParticipantData from its wire format, returning the number of bytes read
+func (p *ParticipantData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 54 {
+		return 0, fmt.Errorf("unable to decode ParticipantData: need 54 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 54, nil
+}
+
+// PacketSize size of ParticipantData on the wire in bytes
+func (p *ParticipantData) PacketSize() int {
+	return 54
+}
+
+// decode decode ParticipantData from data holding at least 54 bytes
+func (p *ParticipantData) decode(data []byte) error {
+	if data[0] > 1 {
+		return fmt.Errorf("failed to set AIControlled value: unexpected byte value %v is <0 or >1", data[0])
+	}
+	p.AIControlled = bool(data[0] == 1)
+	p.DriverID = uint8(data[1])
+	p.TeamID = uint8(data[2])
+	p.RaceNumber = uint8(data[3])
+	p.Nationality = uint8(data[4])
+	if end := bytes.IndexByte(data[5:5+48], 0); end >= 0 {
+		p.Name = string(data[5 : 5+48][:end])
+	} else {
+		p.Name = string(data[5 : 5+48])
+	}
+	if data[53] > 1 {
+		return fmt.Errorf("failed to set TelemetryPublic value: unexpected byte value %v is <0 or >1", data[53])
+	}
+	p.TelemetryPublic = bool(data[53] == 1)
+	return nil
+}
+
+// DecodeFrom decode ParticipantData2021 from its wire format, returning the number of bytes read
+func (p *ParticipantData2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 56 {
+		return 0, fmt.Errorf("unable to decode ParticipantData2021: need 56 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 56, nil
+}
+
+// PacketSize size of ParticipantData2021 on the wire in bytes
+func (p *ParticipantData2021) PacketSize() int {
+	return 56
+}
+
+// decode decode ParticipantData2021 from data holding at least 56 bytes
+func (p *ParticipantData2021) decode(data []byte) error {
+	if data[0] > 1 {
+		return fmt.Errorf("failed to set AIControlled value: unexpected byte value %v is <0 or >1", data[0])
+	}
+	p.AIControlled = bool(data[0] == 1)
+	p.DriverID = uint8(data[1])
+	p.NetworkID = uint8(data[2])
+	p.TeamID = uint8(data[3])
+	if data[4] > 1 {
+		return fmt.Errorf("failed to set MyTeam value: unexpected byte value %v is <0 or >1", data[4])
+	}
+	p.MyTeam = bool(data[4] == 1)
+	p.RaceNumber = uint8(data[5])
+	p.Nationality = uint8(data[6])
+	if end := bytes.IndexByte(data[7:7+48], 0); end >= 0 {
+		p.Name = string(data[7 : 7+48][:end])
+	} else {
+		p.Name = string(data[7 : 7+48])
+	}
+	if data[55] > 1 {
+		return fmt.Errorf("failed to set TelemetryPublic value: unexpected byte value %v is <0 or >1", data[55])
+	}
+	p.TelemetryPublic = bool(data[55] == 1)
+	return nil
+}
@@ -0,0 +1,62 @@
+package participants
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet participants data
+type Packet struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// NumActiveCars number of active cars in the data
+	NumActiveCars uint8 `json:"num_active_cars" packet:"0"`
+	// Participants data for all cars in the session
+	Participants [22]ParticipantData `json:"participants" packet:"1"`
+}
+
+// DriverIDs identifier of the driver of each car
+func (p *Packet) DriverIDs() []uint8 {
+	ids := make([]uint8, len(p.Participants))
+	for i, participant := range p.Participants {
+		ids[i] = participant.DriverID
+	}
+	return ids
+}
+
+// TeamIDs identifier of the team of each car
+func (p *Packet) TeamIDs() []uint8 {
+	ids := make([]uint8, len(p.Participants))
+	for i, participant := range p.Participants {
+		ids[i] = participant.TeamID
+	}
+	return ids
+}
+
+// NationalityIDs identifier of the nationality of each car's driver
+func (p *Packet) NationalityIDs() []uint8 {
+	ids := make([]uint8, len(p.Participants))
+	for i, participant := range p.Participants {
+		ids[i] = participant.Nationality
+	}
+	return ids
+}
+
+// ParticipantData per-car participant data
+type ParticipantData struct {
+	// AIControlled whether the car is controlled by the AI
+	AIControlled bool `json:"ai_controlled" packet:"0"`
+	// DriverID identifier of the driver, 255 if a network human
+	DriverID uint8 `json:"driver_id" packet:"1"`
+	// TeamID identifier of the team
+	TeamID uint8 `json:"team_id" packet:"2"`
+	// RaceNumber race number of the car
+	RaceNumber uint8 `json:"race_number" packet:"3"`
+	// Nationality identifier of the driver's nationality
+	Nationality uint8 `json:"nationality" packet:"4"`
+	// Name name of the participant, i.e. "Lewis HAMILTON"
+	Name string `json:"name" packet:"5" length:"48"`
+	// TelemetryPublic whether the player's UDP telemetry setting is public (true) or restricted
+	TelemetryPublic bool `json:"telemetry_public" packet:"6"`
+}
@@ -0,0 +1,42 @@
+package participants
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet2019 F1 2019 participants data
+type Packet2019 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// NumActiveCars number of active cars in the data
+	NumActiveCars uint8 `json:"num_active_cars" packet:"0"`
+	// Participants data for all cars in the session
+	Participants [20]ParticipantData `json:"participants" packet:"1"`
+}
+
+// DriverIDs identifier of the driver of each car
+func (p *Packet2019) DriverIDs() []uint8 {
+	ids := make([]uint8, len(p.Participants))
+	for i, participant := range p.Participants {
+		ids[i] = participant.DriverID
+	}
+	return ids
+}
+
+// TeamIDs identifier of the team of each car
+func (p *Packet2019) TeamIDs() []uint8 {
+	ids := make([]uint8, len(p.Participants))
+	for i, participant := range p.Participants {
+		ids[i] = participant.TeamID
+	}
+	return ids
+}
+
+// NationalityIDs identifier of the nationality of each car's driver
+func (p *Packet2019) NationalityIDs() []uint8 {
+	ids := make([]uint8, len(p.Participants))
+	for i, participant := range p.Participants {
+		ids[i] = participant.Nationality
+	}
+	return ids
+}
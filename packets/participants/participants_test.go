@@ -0,0 +1,137 @@
+package participants_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"github.com/roryphillips/f1-telemetry-client/packets/participants"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// name the name of driver i, driver 0 using all 48 bytes of the field
+func name(i int) string {
+	if i == 0 {
+		return strings.Repeat("n", 48)
+	}
+	return "Driver " + strconv.Itoa(i)
+}
+
+// participant append participant i in the layout used until 2020
+func participant(b *packettest.Builder, i int) {
+	b.Bool(i%2 == 0).U8(uint8(i + 1)).U8(uint8(i + 2)).U8(uint8(i + 3)).U8(uint8(i + 4))
+	b.String(name(i), 48)
+	b.Bool(i%3 == 0)
+}
+
+// checkParticipant whether participant i holds the values appended by participant
+func checkParticipant(t *testing.T, layout string, i int, p participants.ParticipantData) {
+	t.Helper()
+	want := participants.ParticipantData{
+		AIControlled:    i%2 == 0,
+		DriverID:        uint8(i + 1),
+		TeamID:          uint8(i + 2),
+		RaceNumber:      uint8(i + 3),
+		Nationality:     uint8(i + 4),
+		Name:            name(i),
+		TelemetryPublic: i%3 == 0,
+	}
+	if p != want {
+		t.Errorf("%v: participant %v is %+v, want %+v", layout, i, p, want)
+	}
+}
+
+func TestGolden2019(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2019, common.PacketIDParticipants)
+	b := &packettest.Builder{}
+	b.U8(20)
+	for i := 0; i < 20; i++ {
+		participant(b, i)
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*participants.Packet2019)
+	if p.NumActiveCars != 20 {
+		t.Errorf("%v: %v active cars", layout.Name, p.NumActiveCars)
+	}
+	for i, participant := range p.Participants {
+		checkParticipant(t, layout.Name, i, participant)
+	}
+}
+
+func TestGolden2020(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2020, common.PacketIDParticipants)
+	b := &packettest.Builder{}
+	b.U8(22)
+	for i := 0; i < 22; i++ {
+		participant(b, i)
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*participants.Packet)
+	if p.NumActiveCars != 22 {
+		t.Errorf("%v: %v active cars", layout.Name, p.NumActiveCars)
+	}
+	for i, participant := range p.Participants {
+		checkParticipant(t, layout.Name, i, participant)
+	}
+}
+
+func TestGolden2021(t *testing.T) {
+	for _, format := range []uint16{common.PacketFormat2021, common.PacketFormat2022} {
+		layout := packettest.LayoutOf(format, common.PacketIDParticipants)
+		b := &packettest.Builder{}
+		b.U8(21)
+		for i := 0; i < 22; i++ {
+			b.Bool(i%2 == 0).U8(uint8(i + 1)).U8(uint8(i + 2)).U8(uint8(i + 3)).Bool(i == 5)
+			b.U8(uint8(i + 4)).U8(uint8(i + 5))
+			b.String(name(i), 48)
+			b.Bool(i%3 == 0)
+		}
+
+		p := packettest.Golden(t, layout, b.Bytes()).(*participants.Packet2021)
+		if p.NumActiveCars != 21 {
+			t.Errorf("%v: %v active cars", layout.Name, p.NumActiveCars)
+		}
+		for i, participant := range p.Participants {
+			want := participants.ParticipantData2021{
+				AIControlled:    i%2 == 0,
+				DriverID:        uint8(i + 1),
+				NetworkID:       uint8(i + 2),
+				TeamID:          uint8(i + 3),
+				MyTeam:          i == 5,
+				RaceNumber:      uint8(i + 4),
+				Nationality:     uint8(i + 5),
+				Name:            name(i),
+				TelemetryPublic: i%3 == 0,
+			}
+			if participant != want {
+				t.Errorf("%v: participant %v is %+v, want %+v", layout.Name, i, participant, want)
+			}
+		}
+	}
+}
+func TestFullLengthName(t *testing.T) {
+	writer := packets.NewPacketWriter()
+	name := strings.Repeat("a", 48)
+	packet := &participants.Packet2021{}
+	packet.Participants[0].Name = name
+	data, err := writer.Encode(packet)
+	if err != nil {
+		t.Fatalf("failed to encode a %v byte name: %v", len(name), err)
+	}
+
+	decoded := &participants.Packet2021{}
+	_, err = decoded.DecodeFrom(data)
+	if err != nil {
+		t.Fatalf("failed to decode: %v", err)
+	}
+	if decoded.Participants[0].Name != name {
+		t.Errorf("decoded name %q, want %q", decoded.Participants[0].Name, name)
+	}
+
+	packet.Participants[0].Name = name + "a"
+	_, err = writer.Encode(packet)
+	if err == nil {
+		t.Errorf("encoded a %v byte name into 48 bytes", len(packet.Participants[0].Name))
+	}
+}
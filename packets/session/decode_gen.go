@@ -0,0 +1,397 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package session
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// DecodeFrom decode MarshalZone from its wire format, returning the number of bytes read
+func (p *MarshalZone) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 5 {
+		return 0, fmt.Errorf("unable to decode MarshalZone: need 5 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 5, nil
+}
+
+// PacketSize size of MarshalZone on the wire in bytes
+func (p *MarshalZone) PacketSize() int {
+	return 5
+}
+
+// decode decode MarshalZone from data holding at least 5 bytes
+func (p *MarshalZone) decode(data []byte) error {
+	p.ZoneStart = float32(math.Float32frombits(binary.LittleEndian.Uint32(data[0:])))
+	p.ZoneFlag = ZoneFlag(data[4])
+	return nil
+}
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 227 {
+		return 0, fmt.Errorf("unable to decode Packet: need 227 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 227, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 227
+}
+
+// decode decode Packet from data holding at least 227 bytes
+func (p *Packet) decode(data []byte) error {
+	p.Weather = WeatherType(data[0])
+	p.TrackTemperature = int8(data[1])
+	p.AirTemperature = int8(data[2])
+	p.TotalLaps = uint8(data[3])
+	p.TrackLength = uint16(binary.LittleEndian.Uint16(data[4:]))
+	p.Session = SessionType(data[6])
+	p.Track = TrackType(data[7])
+	p.Formula = FormulaType(data[8])
+	p.SessionTimeLeft = uint16(binary.LittleEndian.Uint16(data[9:]))
+	p.SessionDuration = uint16(binary.LittleEndian.Uint16(data[11:]))
+	p.PitSpeedLimit = uint8(data[13])
+	if data[14] > 1 {
+		return fmt.Errorf("failed to set GamePaused value: unexpected byte value %v is <0 or >1", data[14])
+	}
+	p.GamePaused = bool(data[14] == 1)
+	if data[15] > 1 {
+		return fmt.Errorf("failed to set IsSpectating value: unexpected byte value %v is <0 or >1", data[15])
+	}
+	p.IsSpectating = bool(data[15] == 1)
+	p.SpectatorCarIndex = uint8(data[16])
+	if data[17] > 1 {
+		return fmt.Errorf("failed to set SLIProNativeSupport value: unexpected byte value %v is <0 or >1", data[17])
+	}
+	p.SLIProNativeSupport = bool(data[17] == 1)
+	p.NumMarshalZones = uint8(data[18])
+	for i0 := range p.MarshalZones {
+		if err := p.MarshalZones[i0].decode(data[19+i0*5:]); err != nil {
+			return fmt.Errorf("unable to set struct field MarshalZones item: %v", err)
+		}
+	}
+	p.SafetyCarStatus = SafetyCarStatus(data[124])
+	if data[125] > 1 {
+		return fmt.Errorf("failed to set NetworkGame value: unexpected byte value %v is <0 or >1", data[125])
+	}
+	p.NetworkGame = bool(data[125] == 1)
+	p.NumWeatherForecastSamples = uint8(data[126])
+	for i0 := range p.WeatherForecastSamples {
+		if err := p.WeatherForecastSamples[i0].decode(data[127+i0*5:]); err != nil {
+			return fmt.Errorf("unable to set struct field WeatherForecastSamples item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode Packet2019 from its wire format, returning the number of bytes read
+func (p *Packet2019) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 126 {
+		return 0, fmt.Errorf("unable to decode Packet2019: need 126 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 126, nil
+}
+
+// PacketSize size of Packet2019 on the wire in bytes
+func (p *Packet2019) PacketSize() int {
+	return 126
+}
+
+// decode decode Packet2019 from data holding at least 126 bytes
+func (p *Packet2019) decode(data []byte) error {
+	p.Weather = WeatherType(data[0])
+	p.TrackTemperature = int8(data[1])
+	p.AirTemperature = int8(data[2])
+	p.TotalLaps = uint8(data[3])
+	p.TrackLength = uint16(binary.LittleEndian.Uint16(data[4:]))
+	p.Session = SessionType(data[6])
+	p.Track = TrackType(data[7])
+	p.Formula = FormulaType(data[8])
+	p.SessionTimeLeft = uint16(binary.LittleEndian.Uint16(data[9:]))
+	p.SessionDuration = uint16(binary.LittleEndian.Uint16(data[11:]))
+	p.PitSpeedLimit = uint8(data[13])
+	if data[14] > 1 {
+		return fmt.Errorf("failed to set GamePaused value: unexpected byte value %v is <0 or >1", data[14])
+	}
+	p.GamePaused = bool(data[14] == 1)
+	if data[15] > 1 {
+		return fmt.Errorf("failed to set IsSpectating value: unexpected byte value %v is <0 or >1", data[15])
+	}
+	p.IsSpectating = bool(data[15] == 1)
+	p.SpectatorCarIndex = uint8(data[16])
+	if data[17] > 1 {
+		return fmt.Errorf("failed to set SLIProNativeSupport value: unexpected byte value %v is <0 or >1", data[17])
+	}
+	p.SLIProNativeSupport = bool(data[17] == 1)
+	p.NumMarshalZones = uint8(data[18])
+	for i0 := range p.MarshalZones {
+		if err := p.MarshalZones[i0].decode(data[19+i0*5:]); err != nil {
+			return fmt.Errorf("unable to set struct field MarshalZones item: %v", err)
+		}
+	}
+	p.SafetyCarStatus = SafetyCarStatus(data[124])
+	if data[125] > 1 {
+		return fmt.Errorf("failed to set NetworkGame value: unexpected byte value %v is <0 or >1", data[125])
+	}
+	p.NetworkGame = bool(data[125] == 1)
+	return nil
+}
+
+// DecodeFrom decode Packet2021 from its wire format, returning the number of bytes read
+func (p *Packet2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 601 {
+		return 0, fmt.Errorf("unable to decode Packet2021: need 601 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 601, nil
+}
+
+// PacketSize size of Packet2021 on the wire in bytes
+func (p *Packet2021) PacketSize() int {
+	return 601
+}
+
+// decode decode Packet2021 from data holding at least 601 bytes
+func (p *Packet2021) decode(data []byte) error {
+	p.Weather = WeatherType(data[0])
+	p.TrackTemperature = int8(data[1])
+	p.AirTemperature = int8(data[2])
+	p.TotalLaps = uint8(data[3])
+	p.TrackLength = uint16(binary.LittleEndian.Uint16(data[4:]))
+	p.Session = SessionType(data[6])
+	p.Track = TrackType(data[7])
+	p.Formula = FormulaType(data[8])
+	p.SessionTimeLeft = uint16(binary.LittleEndian.Uint16(data[9:]))
+	p.SessionDuration = uint16(binary.LittleEndian.Uint16(data[11:]))
+	p.PitSpeedLimit = uint8(data[13])
+	if data[14] > 1 {
+		return fmt.Errorf("failed to set GamePaused value: unexpected byte value %v is <0 or >1", data[14])
+	}
+	p.GamePaused = bool(data[14] == 1)
+	if data[15] > 1 {
+		return fmt.Errorf("failed to set IsSpectating value: unexpected byte value %v is <0 or >1", data[15])
+	}
+	p.IsSpectating = bool(data[15] == 1)
+	p.SpectatorCarIndex = uint8(data[16])
+	if data[17] > 1 {
+		return fmt.Errorf("failed to set SLIProNativeSupport value: unexpected byte value %v is <0 or >1", data[17])
+	}
+	p.SLIProNativeSupport = bool(data[17] == 1)
+	p.NumMarshalZones = uint8(data[18])
+	for i0 := range p.MarshalZones {
+		if err := p.MarshalZones[i0].decode(data[19+i0*5:]); err != nil {
+			return fmt.Errorf("unable to set struct field MarshalZones item: %v", err)
+		}
+	}
+	p.SafetyCarStatus = SafetyCarStatus(data[124])
+	if data[125] > 1 {
+		return fmt.Errorf("failed to set NetworkGame value: unexpected byte value %v is <0 or >1", data[125])
+	}
+	p.NetworkGame = bool(data[125] == 1)
+	p.NumWeatherForecastSamples = uint8(data[126])
+	for i0 := range p.WeatherForecastSamples {
+		if err := p.WeatherForecastSamples[i0].decode(data[127+i0*8:]); err != nil {
+			return fmt.Errorf("unable to set struct field WeatherForecastSamples item: %v", err)
+		}
+	}
+	p.ForecastAccuracy = ForecastAccuracy(data[575])
+	p.AIDifficulty = uint8(data[576])
+	p.SeasonLinkIdentifier = uint32(binary.LittleEndian.Uint32(data[577:]))
+	p.WeekendLinkIdentifier = uint32(binary.LittleEndian.Uint32(data[581:]))
+	p.SessionLinkIdentifier = uint32(binary.LittleEndian.Uint32(data[585:]))
+	p.PitStopWindowIdealLap = uint8(data[589])
+	p.PitStopWindowLatestLap = uint8(data[590])
+	p.PitStopRejoinPosition = uint8(data[591])
+	if data[592] > 1 {
+		return fmt.Errorf("failed to set SteeringAssist value: unexpected byte value %v is <0 or >1", data[592])
+	}
+	p.SteeringAssist = bool(data[592] == 1)
+	p.BrakingAssist = BrakingAssist(data[593])
+	p.GearboxAssist = GearboxAssist(data[594])
+	if data[595] > 1 {
+		return fmt.Errorf("failed to set PitAssist value: unexpected byte value %v is <0 or >1", data[595])
+	}
+	p.PitAssist = bool(data[595] == 1)
+	if data[596] > 1 {
+		return fmt.Errorf("failed to set PitReleaseAssist value: unexpected byte value %v is <0 or >1", data[596])
+	}
+	p.PitReleaseAssist = bool(data[596] == 1)
+	if data[597] > 1 {
+		return fmt.Errorf("failed to set ERSAssist value: unexpected byte value %v is <0 or >1", data[597])
+	}
+	p.ERSAssist = bool(data[597] == 1)
+	if data[598] > 1 {
+		return fmt.Errorf("failed to set DRSAssist value: unexpected byte value %v is <0 or >1", data[598])
+	}
+	p.DRSAssist = bool(data[598] == 1)
+	p.DynamicRacingLine = DynamicRacingLine(data[599])
+	p.DynamicRacingLineType = DynamicRacingLineType(data[600])
+	return nil
+}
+
+// DecodeFrom decode Packet2022 from its wire format, returning the number of bytes read
+func (p *Packet2022) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 608 {
+		return 0, fmt.Errorf("unable to decode Packet2022: need 608 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 608, nil
+}
+
+// PacketSize size of Packet2022 on the wire in bytes
+func (p *Packet2022) PacketSize() int {
+	return 608
+}
+
+// decode decode Packet2022 from data holding at least 608 bytes
+func (p *Packet2022) decode(data []byte) error {
+	p.Weather = WeatherType(data[0])
+	p.TrackTemperature = int8(data[1])
+	p.AirTemperature = int8(data[2])
+	p.TotalLaps = uint8(data[3])
+	p.TrackLength = uint16(binary.LittleEndian.Uint16(data[4:]))
+	p.Session = SessionType(data[6])
+	p.Track = TrackType(data[7])
+	p.Formula = FormulaType(data[8])
+	p.SessionTimeLeft = uint16(binary.LittleEndian.Uint16(data[9:]))
+	p.SessionDuration = uint16(binary.LittleEndian.Uint16(data[11:]))
+	p.PitSpeedLimit = uint8(data[13])
+	if data[14] > 1 {
+		return fmt.Errorf("failed to set GamePaused value: unexpected byte value %v is <0 or >1", data[14])
+	}
+	p.GamePaused = bool(data[14] == 1)
+	if data[15] > 1 {
+		return fmt.Errorf("failed to set IsSpectating value: unexpected byte value %v is <0 or >1", data[15])
+	}
+	p.IsSpectating = bool(data[15] == 1)
+	p.SpectatorCarIndex = uint8(data[16])
+	if data[17] > 1 {
+		return fmt.Errorf("failed to set SLIProNativeSupport value: unexpected byte value %v is <0 or >1", data[17])
+	}
+	p.SLIProNativeSupport = bool(data[17] == 1)
+	p.NumMarshalZones = uint8(data[18])
+	for i0 := range p.MarshalZones {
+		if err := p.MarshalZones[i0].decode(data[19+i0*5:]); err != nil {
+			return fmt.Errorf("unable to set struct field MarshalZones item: %v", err)
+		}
+	}
+	p.SafetyCarStatus = SafetyCarStatus(data[124])
+	if data[125] > 1 {
+		return fmt.Errorf("failed to set NetworkGame value: unexpected byte value %v is <0 or >1", data[125])
+	}
+	p.NetworkGame = bool(data[125] == 1)
+	p.NumWeatherForecastSamples = uint8(data[126])
+	for i0 := range p.WeatherForecastSamples {
+		if err := p.WeatherForecastSamples[i0].decode(data[127+i0*8:]); err != nil {
+			return fmt.Errorf("unable to set struct field WeatherForecastSamples item: %v", err)
+		}
+	}
+	p.ForecastAccuracy = ForecastAccuracy(data[575])
+	p.AIDifficulty = uint8(data[576])
+	p.SeasonLinkIdentifier = uint32(binary.LittleEndian.Uint32(data[577:]))
+	p.WeekendLinkIdentifier = uint32(binary.LittleEndian.Uint32(data[581:]))
+	p.SessionLinkIdentifier = uint32(binary.LittleEndian.Uint32(data[585:]))
+	p.PitStopWindowIdealLap = uint8(data[589])
+	p.PitStopWindowLatestLap = uint8(data[590])
+	p.PitStopRejoinPosition = uint8(data[591])
+	if data[592] > 1 {
+		return fmt.Errorf("failed to set SteeringAssist value: unexpected byte value %v is <0 or >1", data[592])
+	}
+	p.SteeringAssist = bool(data[592] == 1)
+	p.BrakingAssist = BrakingAssist(data[593])
+	p.GearboxAssist = GearboxAssist(data[594])
+	if data[595] > 1 {
+		return fmt.Errorf("failed to set PitAssist value: unexpected byte value %v is <0 or >1", data[595])
+	}
+	p.PitAssist = bool(data[595] == 1)
+	if data[596] > 1 {
+		return fmt.Errorf("failed to set PitReleaseAssist value: unexpected byte value %v is <0 or >1", data[596])
+	}
+	p.PitReleaseAssist = bool(data[596] == 1)
+	if data[597] > 1 {
+		return fmt.Errorf("failed to set ERSAssist value: unexpected byte value %v is <0 or >1", data[597])
+	}
+	p.ERSAssist = bool(data[597] == 1)
+	if data[598] > 1 {
+		return fmt.Errorf("failed to set DRSAssist value: unexpected byte value %v is <0 or >1", data[598])
+	}
+	p.DRSAssist = bool(data[598] == 1)
+	p.DynamicRacingLine = DynamicRacingLine(data[599])
+	p.DynamicRacingLineType = DynamicRacingLineType(data[600])
+	p.GameMode = GameMode(data[601])
+	p.RuleSet = RuleSet(data[602])
+	p.TimeOfDay = uint32(binary.LittleEndian.Uint32(data[603:]))
+	p.SessionLength = SessionLength(data[607])
+	return nil
+}
+
+// DecodeFrom decode WeatherForecastSample from its wire format, returning the number of bytes read
+func (p *WeatherForecastSample) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 5 {
+		return 0, fmt.Errorf("unable to decode WeatherForecastSample: need 5 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 5, nil
+}
+
+// PacketSize size of WeatherForecastSample on the wire in bytes
+func (p *WeatherForecastSample) PacketSize() int {
+	return 5
+}
+
+// decode decode WeatherForecastSample from data holding at least 5 bytes
+func (p *WeatherForecastSample) decode(data []byte) error {
+	p.Session = SessionType(data[0])
+	p.TimeOffset = uint8(data[1])
+	p.Weather = WeatherType(data[2])
+	p.TrackTemperature = int8(data[3])
+	p.AirTemperature = int8(data[4])
+	return nil
+}
+
+// DecodeFrom decode WeatherForecastSample2021 from its wire format, returning the number of bytes read
+func (p *WeatherForecastSample2021) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 8 {
+		return 0, fmt.Errorf("unable to decode WeatherForecastSample2021: need 8 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 8, nil
+}
+
+// PacketSize size of WeatherForecastSample2021 on the wire in bytes
+func (p *WeatherForecastSample2021) PacketSize() int {
+	return 8
+}
+
+// decode decode WeatherForecastSample2021 from data holding at least 8 bytes
+func (p *WeatherForecastSample2021) decode(data []byte) error {
+	p.Session = SessionType(data[0])
+	p.TimeOffset = uint8(data[1])
+	p.Weather = WeatherType(data[2])
+	p.TrackTemperature = int8(data[3])
+	p.TrackTemperatureChange = TemperatureChange(data[4])
+	p.AirTemperature = int8(data[5])
+	p.AirTemperatureChange = TemperatureChange(data[6])
+	p.RainPercentage = uint8(data[7])
+	return nil
+}
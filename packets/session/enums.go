@@ -0,0 +1,316 @@
+package session
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/enum-gen
+
+// SessionType type of session
+type SessionType uint8
+
+const (
+	// SessionTypeUnknown unknown session type
+	SessionTypeUnknown SessionType = 0
+	// SessionTypePractice1 practice one
+	SessionTypePractice1 SessionType = 1
+	// SessionTypePractice2 practice two
+	SessionTypePractice2 SessionType = 2
+	// SessionTypePractice3 practice three
+	SessionTypePractice3 SessionType = 3
+	// SessionTypeShortPractice short practice
+	SessionTypeShortPractice SessionType = 4
+	// SessionTypeQualifying1 qualifying one
+	SessionTypeQualifying1 SessionType = 5
+	// SessionTypeQualifying2 qualifying two
+	SessionTypeQualifying2 SessionType = 6
+	// SessionTypeQualifying3 qualifying three
+	SessionTypeQualifying3 SessionType = 7
+	// SessionTypeShortQualifying short qualifying
+	SessionTypeShortQualifying SessionType = 8
+	// SessionTypeOneShotQualifying one shot qualifying
+	SessionTypeOneShotQualifying SessionType = 9
+	// SessionTypeRace1 race one
+	SessionTypeRace1 SessionType = 10
+	// SessionTypeRace2 race two
+	SessionTypeRace2 SessionType = 11
+	// SessionTypeTimeTrial time trial
+	SessionTypeTimeTrial SessionType = 12
+)
+
+// WeatherType type of weather
+type WeatherType uint8
+
+const (
+	//WeatherTypeClear clear
+	WeatherTypeClear WeatherType = 0
+	//WeatherTypeLightCloud light cloud
+	WeatherTypeLightCloud WeatherType = 1
+	//WeatherTypeOvercast overcast
+	WeatherTypeOvercast WeatherType = 2
+	//WeatherTypeLightRain light rain
+	WeatherTypeLightRain WeatherType = 3
+	//WeatherTypeHeavyRain heavy rain
+	WeatherTypeHeavyRain WeatherType = 4
+	//WeatherTypeStorm storm
+	WeatherTypeStorm WeatherType = 5
+)
+
+// TrackType track the session takes place at
+type TrackType int8
+
+const (
+	// TrackTypeUnknown Unknown track
+	TrackTypeUnknown TrackType = -1
+	// TrackTypeMelbourne Melbourne
+	TrackTypeMelbourne TrackType = 0
+	// TrackTypePaulRicard Paul Ricard
+	TrackTypePaulRicard TrackType = 1
+	// TrackTypeShanghai Shanghai
+	TrackTypeShanghai TrackType = 2
+	// TrackTypeSakhir Sakhir (Bahrain)
+	TrackTypeSakhir TrackType = 3
+	// TrackTypeCatalunya Catalunya
+	TrackTypeCatalunya TrackType = 4
+	// TrackTypeMonaco Monaco
+	TrackTypeMonaco TrackType = 5
+	// TrackTypeMontreal Montreal
+	TrackTypeMontreal TrackType = 6
+	// TrackTypeSilverstone Silverstone
+	TrackTypeSilverstone TrackType = 7
+	// TrackTypeHockenheim Hockenheim
+	TrackTypeHockenheim TrackType = 8
+	// TrackTypeHungaroring Hungaroring
+	TrackTypeHungaroring TrackType = 9
+	// TrackTypeSpa Spa
+	TrackTypeSpa TrackType = 10
+	// TrackTypeMonza Monza
+	TrackTypeMonza TrackType = 11
+	// TrackTypeSingapore Singapore
+	TrackTypeSingapore TrackType = 12
+	// TrackTypeSuzuka Suzuka
+	TrackTypeSuzuka TrackType = 13
+	// TrackTypeAbuDhabi Abu Dhabi
+	TrackTypeAbuDhabi TrackType = 14
+	// TrackTypeTexas Texas
+	TrackTypeTexas TrackType = 15
+	// TrackTypeBrazil Brazil
+	TrackTypeBrazil TrackType = 16
+	// TrackTypeAustria Austria
+	TrackTypeAustria TrackType = 17
+	// TrackTypeSochi Sochi
+	TrackTypeSochi TrackType = 18
+	// TrackTypeMexico Mexico
+	TrackTypeMexico TrackType = 19
+	// TrackTypeBaku Baku (Azerbaijan)
+	TrackTypeBaku TrackType = 20
+	// TrackTypeSakhirShort Sakhir short
+	TrackTypeSakhirShort TrackType = 21
+	// TrackTypeSilverstoneShort Silverstone short
+	TrackTypeSilverstoneShort TrackType = 22
+	// TrackTypeTexasShort Texas short
+	TrackTypeTexasShort TrackType = 23
+	// TrackTypeSuzukaShort Suzuka short
+	TrackTypeSuzukaShort TrackType = 24
+	// TrackTypeHanoi Hanoi - F1 2020 onwards
+	TrackTypeHanoi TrackType = 25
+	// TrackTypeZandvoort Zandvoort - F1 2020 onwards
+	TrackTypeZandvoort TrackType = 26
+	// TrackTypeImola Imola - F1 2021 onwards
+	TrackTypeImola TrackType = 27
+	// TrackTypePortimao Portimão - F1 2021 onwards
+	TrackTypePortimao TrackType = 28
+	// TrackTypeJeddah Jeddah - F1 2021 onwards
+	TrackTypeJeddah TrackType = 29
+	// TrackTypeMiami Miami - F1 2022 onwards
+	TrackTypeMiami TrackType = 30
+)
+
+type FormulaType uint8
+
+const (
+	// FormulaTypeF1Modern F1 Modern
+	FormulaTypeF1Modern FormulaType = 0
+	// FormulaTypeF1Classic F1 Classic
+	FormulaTypeF1Classic FormulaType = 1
+	// FormulaTypeF2 F2
+	FormulaTypeF2 FormulaType = 2
+	// FormulaTypeF1Generic F1 Generic
+	FormulaTypeF1Generic FormulaType = 3
+)
+
+// ZoneFlag flag flown
+type ZoneFlag int8
+
+const (
+	// ZoneFlagUnknown unknown flag
+	ZoneFlagUnknown ZoneFlag = -1
+	// ZoneFlagNone no flag type
+	ZoneFlagNone ZoneFlag = 0
+	// ZoneFlagGreen green flag
+	ZoneFlagGreen ZoneFlag = 1
+	// ZoneFlagBlue blue flag
+	ZoneFlagBlue ZoneFlag = 2
+	// ZoneFlagYellow yellow flag
+	ZoneFlagYellow ZoneFlag = 3
+	// ZoneFlagRed red flag
+	ZoneFlagRed ZoneFlag = 4
+)
+
+// SafetyCarStatus status of the safety car
+type SafetyCarStatus uint8
+
+const (
+	// SafetyCarStatusNone no safety car
+	SafetyCarStatusNone SafetyCarStatus = 0
+	// SafetyCarStatusFull full safety car
+	SafetyCarStatusFull SafetyCarStatus = 1
+	// SafetyCarStatusVirtual virtual safety car
+	SafetyCarStatusVirtual SafetyCarStatus = 2
+	// SafetyCarStatusFormationLap formation lap - F1 2020 onwards
+	SafetyCarStatusFormationLap SafetyCarStatus = 3
+)
+
+// TemperatureChange direction a forecast temperature is changing in
+type TemperatureChange int8
+
+const (
+	// TemperatureChangeUp temperature rising
+	TemperatureChangeUp TemperatureChange = 0
+	// TemperatureChangeDown temperature falling
+	TemperatureChangeDown TemperatureChange = 1
+	// TemperatureChangeNone no change in temperature
+	TemperatureChangeNone TemperatureChange = 2
+)
+
+// ForecastAccuracy accuracy of the weather forecast
+type ForecastAccuracy uint8
+
+const (
+	// ForecastAccuracyPerfect perfect
+	ForecastAccuracyPerfect ForecastAccuracy = 0
+	// ForecastAccuracyApproximate approximate
+	ForecastAccuracyApproximate ForecastAccuracy = 1
+)
+
+// BrakingAssist braking assist level
+type BrakingAssist uint8
+
+const (
+	// BrakingAssistOff off
+	BrakingAssistOff BrakingAssist = 0
+	// BrakingAssistLow low
+	BrakingAssistLow BrakingAssist = 1
+	// BrakingAssistMedium medium
+	BrakingAssistMedium BrakingAssist = 2
+	// BrakingAssistHigh high
+	BrakingAssistHigh BrakingAssist = 3
+)
+
+// GearboxAssist gearbox assist level
+type GearboxAssist uint8
+
+const (
+	// GearboxAssistManual manual
+	GearboxAssistManual GearboxAssist = 1
+	// GearboxAssistManualSuggestedGear manual with suggested gear
+	GearboxAssistManualSuggestedGear GearboxAssist = 2
+	// GearboxAssistAuto automatic
+	GearboxAssistAuto GearboxAssist = 3
+)
+
+// DynamicRacingLine dynamic racing line assist
+type DynamicRacingLine uint8
+
+const (
+	// DynamicRacingLineOff off
+	DynamicRacingLineOff DynamicRacingLine = 0
+	// DynamicRacingLineCornersOnly corners only
+	DynamicRacingLineCornersOnly DynamicRacingLine = 1
+	// DynamicRacingLineFull full
+	DynamicRacingLineFull DynamicRacingLine = 2
+)
+
+// DynamicRacingLineType how the dynamic racing line is drawn
+type DynamicRacingLineType uint8
+
+const (
+	// DynamicRacingLineType2D 2D
+	DynamicRacingLineType2D DynamicRacingLineType = 0
+	// DynamicRacingLineType3D 3D
+	DynamicRacingLineType3D DynamicRacingLineType = 1
+)
+
+// GameMode game mode being played - F1 2022 onwards
+type GameMode uint8
+
+const (
+	// GameModeEventMode event mode
+	GameModeEventMode GameMode = 0
+	// GameModeGrandPrix grand prix
+	GameModeGrandPrix GameMode = 3
+	// GameModeTimeTrial time trial
+	GameModeTimeTrial GameMode = 5
+	// GameModeSplitscreen splitscreen
+	GameModeSplitscreen GameMode = 6
+	// GameModeOnlineCustom online custom
+	GameModeOnlineCustom GameMode = 7
+	// GameModeOnlineLeague online league
+	GameModeOnlineLeague GameMode = 8
+	// GameModeCareerInvitational career invitational
+	GameModeCareerInvitational GameMode = 11
+	// GameModeChampionshipInvitational championship invitational
+	GameModeChampionshipInvitational GameMode = 12
+	// GameModeChampionship championship
+	GameModeChampionship GameMode = 13
+	// GameModeOnlineChampionship online championship
+	GameModeOnlineChampionship GameMode = 14
+	// GameModeOnlineWeeklyEvent online weekly event
+	GameModeOnlineWeeklyEvent GameMode = 15
+	// GameModeCareer22 career '22
+	GameModeCareer22 GameMode = 19
+	// GameModeCareer22Online career '22 online
+	GameModeCareer22Online GameMode = 20
+	// GameModeBenchmark benchmark
+	GameModeBenchmark GameMode = 127
+)
+
+// RuleSet rules in use for the session - F1 2022 onwards
+type RuleSet uint8
+
+const (
+	// RuleSetPracticeAndQualifying practice and qualifying
+	RuleSetPracticeAndQualifying RuleSet = 0
+	// RuleSetRace race
+	RuleSetRace RuleSet = 1
+	// RuleSetTimeTrial time trial
+	RuleSetTimeTrial RuleSet = 2
+	// RuleSetTimeAttack time attack
+	RuleSetTimeAttack RuleSet = 4
+	// RuleSetCheckpointChallenge checkpoint challenge
+	RuleSetCheckpointChallenge RuleSet = 6
+	// RuleSetAutocross autocross
+	RuleSetAutocross RuleSet = 8
+	// RuleSetDrift drift
+	RuleSetDrift RuleSet = 9
+	// RuleSetAverageSpeedZone average speed zone
+	RuleSetAverageSpeedZone RuleSet = 10
+	// RuleSetRivalDuel rival duel
+	RuleSetRivalDuel RuleSet = 11
+)
+
+// SessionLength length of the session - F1 2022 onwards
+type SessionLength uint8
+
+const (
+	// SessionLengthNone none
+	SessionLengthNone SessionLength = 0
+	// SessionLengthVeryShort very short
+	SessionLengthVeryShort SessionLength = 2
+	// SessionLengthShort short
+	SessionLengthShort SessionLength = 3
+	// SessionLengthMedium medium
+	SessionLengthMedium SessionLength = 4
+	// SessionLengthMediumLong medium long
+	SessionLengthMediumLong SessionLength = 5
+	// SessionLengthLong long
+	SessionLengthLong SessionLength = 6
+	// SessionLengthFull full
+	SessionLengthFull SessionLength = 7
+)
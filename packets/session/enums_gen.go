@@ -0,0 +1,1028 @@
+// Code generated by enum-gen. DO NOT EDIT.
+
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var sessionTypeNames = map[SessionType]string{
+	0:  "unknown",
+	1:  "practice_1",
+	2:  "practice_2",
+	3:  "practice_3",
+	4:  "short_practice",
+	5:  "qualifying_1",
+	6:  "qualifying_2",
+	7:  "qualifying_3",
+	8:  "short_qualifying",
+	9:  "one_shot_qualifying",
+	10: "race_1",
+	11: "race_2",
+	12: "time_trial",
+}
+
+var sessionTypeValues = map[string]SessionType{
+	"unknown":             0,
+	"practice_1":          1,
+	"practice_2":          2,
+	"practice_3":          3,
+	"short_practice":      4,
+	"qualifying_1":        5,
+	"qualifying_2":        6,
+	"qualifying_3":        7,
+	"short_qualifying":    8,
+	"one_shot_qualifying": 9,
+	"race_1":              10,
+	"race_2":              11,
+	"time_trial":          12,
+}
+
+// String name of the SessionType, or the type and number for unknown values
+func (v SessionType) String() string {
+	if name, ok := sessionTypeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("SessionType(%d)", v)
+}
+
+// MarshalText name of the SessionType, or the number for unknown values
+func (v SessionType) MarshalText() ([]byte, error) {
+	if name, ok := sessionTypeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the SessionType from its name or number
+func (v *SessionType) UnmarshalText(text []byte) error {
+	if val, ok := sessionTypeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown SessionType %q", text)
+	}
+	*v = SessionType(n)
+	return nil
+}
+
+// MarshalJSON name of the SessionType as a string, or its number for unknown values
+func (v SessionType) MarshalJSON() ([]byte, error) {
+	if name, ok := sessionTypeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the SessionType from its name as a string, or its number
+func (v *SessionType) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var weatherTypeNames = map[WeatherType]string{
+	0: "clear",
+	1: "light_cloud",
+	2: "overcast",
+	3: "light_rain",
+	4: "heavy_rain",
+	5: "storm",
+}
+
+var weatherTypeValues = map[string]WeatherType{
+	"clear":       0,
+	"light_cloud": 1,
+	"overcast":    2,
+	"light_rain":  3,
+	"heavy_rain":  4,
+	"storm":       5,
+}
+
+// String name of the WeatherType, or the type and number for unknown values
+func (v WeatherType) String() string {
+	if name, ok := weatherTypeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("WeatherType(%d)", v)
+}
+
+// MarshalText name of the WeatherType, or the number for unknown values
+func (v WeatherType) MarshalText() ([]byte, error) {
+	if name, ok := weatherTypeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the WeatherType from its name or number
+func (v *WeatherType) UnmarshalText(text []byte) error {
+	if val, ok := weatherTypeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown WeatherType %q", text)
+	}
+	*v = WeatherType(n)
+	return nil
+}
+
+// MarshalJSON name of the WeatherType as a string, or its number for unknown values
+func (v WeatherType) MarshalJSON() ([]byte, error) {
+	if name, ok := weatherTypeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the WeatherType from its name as a string, or its number
+func (v *WeatherType) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var trackTypeNames = map[TrackType]string{
+	-1: "unknown",
+	0:  "melbourne",
+	1:  "paul_ricard",
+	2:  "shanghai",
+	3:  "sakhir",
+	4:  "catalunya",
+	5:  "monaco",
+	6:  "montreal",
+	7:  "silverstone",
+	8:  "hockenheim",
+	9:  "hungaroring",
+	10: "spa",
+	11: "monza",
+	12: "singapore",
+	13: "suzuka",
+	14: "abu_dhabi",
+	15: "texas",
+	16: "brazil",
+	17: "austria",
+	18: "sochi",
+	19: "mexico",
+	20: "baku",
+	21: "sakhir_short",
+	22: "silverstone_short",
+	23: "texas_short",
+	24: "suzuka_short",
+	25: "hanoi",
+	26: "zandvoort",
+	27: "imola",
+	28: "portimao",
+	29: "jeddah",
+	30: "miami",
+}
+
+var trackTypeValues = map[string]TrackType{
+	"unknown":           -1,
+	"melbourne":         0,
+	"paul_ricard":       1,
+	"shanghai":          2,
+	"sakhir":            3,
+	"catalunya":         4,
+	"monaco":            5,
+	"montreal":          6,
+	"silverstone":       7,
+	"hockenheim":        8,
+	"hungaroring":       9,
+	"spa":               10,
+	"monza":             11,
+	"singapore":         12,
+	"suzuka":            13,
+	"abu_dhabi":         14,
+	"texas":             15,
+	"brazil":            16,
+	"austria":           17,
+	"sochi":             18,
+	"mexico":            19,
+	"baku":              20,
+	"sakhir_short":      21,
+	"silverstone_short": 22,
+	"texas_short":       23,
+	"suzuka_short":      24,
+	"hanoi":             25,
+	"zandvoort":         26,
+	"imola":             27,
+	"portimao":          28,
+	"jeddah":            29,
+	"miami":             30,
+}
+
+// String name of the TrackType, or the type and number for unknown values
+func (v TrackType) String() string {
+	if name, ok := trackTypeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("TrackType(%d)", v)
+}
+
+// MarshalText name of the TrackType, or the number for unknown values
+func (v TrackType) MarshalText() ([]byte, error) {
+	if name, ok := trackTypeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalText set the TrackType from its name or number
+func (v *TrackType) UnmarshalText(text []byte) error {
+	if val, ok := trackTypeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseInt(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown TrackType %q", text)
+	}
+	*v = TrackType(n)
+	return nil
+}
+
+// MarshalJSON name of the TrackType as a string, or its number for unknown values
+func (v TrackType) MarshalJSON() ([]byte, error) {
+	if name, ok := trackTypeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON set the TrackType from its name as a string, or its number
+func (v *TrackType) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var formulaTypeNames = map[FormulaType]string{
+	0: "f1_modern",
+	1: "f1_classic",
+	2: "f2",
+	3: "f1_generic",
+}
+
+var formulaTypeValues = map[string]FormulaType{
+	"f1_modern":  0,
+	"f1_classic": 1,
+	"f2":         2,
+	"f1_generic": 3,
+}
+
+// String name of the FormulaType, or the type and number for unknown values
+func (v FormulaType) String() string {
+	if name, ok := formulaTypeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("FormulaType(%d)", v)
+}
+
+// MarshalText name of the FormulaType, or the number for unknown values
+func (v FormulaType) MarshalText() ([]byte, error) {
+	if name, ok := formulaTypeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the FormulaType from its name or number
+func (v *FormulaType) UnmarshalText(text []byte) error {
+	if val, ok := formulaTypeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown FormulaType %q", text)
+	}
+	*v = FormulaType(n)
+	return nil
+}
+
+// MarshalJSON name of the FormulaType as a string, or its number for unknown values
+func (v FormulaType) MarshalJSON() ([]byte, error) {
+	if name, ok := formulaTypeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the FormulaType from its name as a string, or its number
+func (v *FormulaType) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var zoneFlagNames = map[ZoneFlag]string{
+	-1: "unknown",
+	0:  "none",
+	1:  "green",
+	2:  "blue",
+	3:  "yellow",
+	4:  "red",
+}
+
+var zoneFlagValues = map[string]ZoneFlag{
+	"unknown": -1,
+	"none":    0,
+	"green":   1,
+	"blue":    2,
+	"yellow":  3,
+	"red":     4,
+}
+
+// String name of the ZoneFlag, or the type and number for unknown values
+func (v ZoneFlag) String() string {
+	if name, ok := zoneFlagNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("ZoneFlag(%d)", v)
+}
+
+// MarshalText name of the ZoneFlag, or the number for unknown values
+func (v ZoneFlag) MarshalText() ([]byte, error) {
+	if name, ok := zoneFlagNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalText set the ZoneFlag from its name or number
+func (v *ZoneFlag) UnmarshalText(text []byte) error {
+	if val, ok := zoneFlagValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseInt(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown ZoneFlag %q", text)
+	}
+	*v = ZoneFlag(n)
+	return nil
+}
+
+// MarshalJSON name of the ZoneFlag as a string, or its number for unknown values
+func (v ZoneFlag) MarshalJSON() ([]byte, error) {
+	if name, ok := zoneFlagNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON set the ZoneFlag from its name as a string, or its number
+func (v *ZoneFlag) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var safetyCarStatusNames = map[SafetyCarStatus]string{
+	0: "none",
+	1: "full",
+	2: "virtual",
+	3: "formation_lap",
+}
+
+var safetyCarStatusValues = map[string]SafetyCarStatus{
+	"none":          0,
+	"full":          1,
+	"virtual":       2,
+	"formation_lap": 3,
+}
+
+// String name of the SafetyCarStatus, or the type and number for unknown values
+func (v SafetyCarStatus) String() string {
+	if name, ok := safetyCarStatusNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("SafetyCarStatus(%d)", v)
+}
+
+// MarshalText name of the SafetyCarStatus, or the number for unknown values
+func (v SafetyCarStatus) MarshalText() ([]byte, error) {
+	if name, ok := safetyCarStatusNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the SafetyCarStatus from its name or number
+func (v *SafetyCarStatus) UnmarshalText(text []byte) error {
+	if val, ok := safetyCarStatusValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown SafetyCarStatus %q", text)
+	}
+	*v = SafetyCarStatus(n)
+	return nil
+}
+
+// MarshalJSON name of the SafetyCarStatus as a string, or its number for unknown values
+func (v SafetyCarStatus) MarshalJSON() ([]byte, error) {
+	if name, ok := safetyCarStatusNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the SafetyCarStatus from its name as a string, or its number
+func (v *SafetyCarStatus) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var temperatureChangeNames = map[TemperatureChange]string{
+	0: "up",
+	1: "down",
+	2: "none",
+}
+
+var temperatureChangeValues = map[string]TemperatureChange{
+	"up":   0,
+	"down": 1,
+	"none": 2,
+}
+
+// String name of the TemperatureChange, or the type and number for unknown values
+func (v TemperatureChange) String() string {
+	if name, ok := temperatureChangeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("TemperatureChange(%d)", v)
+}
+
+// MarshalText name of the TemperatureChange, or the number for unknown values
+func (v TemperatureChange) MarshalText() ([]byte, error) {
+	if name, ok := temperatureChangeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalText set the TemperatureChange from its name or number
+func (v *TemperatureChange) UnmarshalText(text []byte) error {
+	if val, ok := temperatureChangeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseInt(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown TemperatureChange %q", text)
+	}
+	*v = TemperatureChange(n)
+	return nil
+}
+
+// MarshalJSON name of the TemperatureChange as a string, or its number for unknown values
+func (v TemperatureChange) MarshalJSON() ([]byte, error) {
+	if name, ok := temperatureChangeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON set the TemperatureChange from its name as a string, or its number
+func (v *TemperatureChange) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var forecastAccuracyNames = map[ForecastAccuracy]string{
+	0: "perfect",
+	1: "approximate",
+}
+
+var forecastAccuracyValues = map[string]ForecastAccuracy{
+	"perfect":     0,
+	"approximate": 1,
+}
+
+// String name of the ForecastAccuracy, or the type and number for unknown values
+func (v ForecastAccuracy) String() string {
+	if name, ok := forecastAccuracyNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("ForecastAccuracy(%d)", v)
+}
+
+// MarshalText name of the ForecastAccuracy, or the number for unknown values
+func (v ForecastAccuracy) MarshalText() ([]byte, error) {
+	if name, ok := forecastAccuracyNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the ForecastAccuracy from its name or number
+func (v *ForecastAccuracy) UnmarshalText(text []byte) error {
+	if val, ok := forecastAccuracyValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown ForecastAccuracy %q", text)
+	}
+	*v = ForecastAccuracy(n)
+	return nil
+}
+
+// MarshalJSON name of the ForecastAccuracy as a string, or its number for unknown values
+func (v ForecastAccuracy) MarshalJSON() ([]byte, error) {
+	if name, ok := forecastAccuracyNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the ForecastAccuracy from its name as a string, or its number
+func (v *ForecastAccuracy) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var brakingAssistNames = map[BrakingAssist]string{
+	0: "off",
+	1: "low",
+	2: "medium",
+	3: "high",
+}
+
+var brakingAssistValues = map[string]BrakingAssist{
+	"off":    0,
+	"low":    1,
+	"medium": 2,
+	"high":   3,
+}
+
+// String name of the BrakingAssist, or the type and number for unknown values
+func (v BrakingAssist) String() string {
+	if name, ok := brakingAssistNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("BrakingAssist(%d)", v)
+}
+
+// MarshalText name of the BrakingAssist, or the number for unknown values
+func (v BrakingAssist) MarshalText() ([]byte, error) {
+	if name, ok := brakingAssistNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the BrakingAssist from its name or number
+func (v *BrakingAssist) UnmarshalText(text []byte) error {
+	if val, ok := brakingAssistValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown BrakingAssist %q", text)
+	}
+	*v = BrakingAssist(n)
+	return nil
+}
+
+// MarshalJSON name of the BrakingAssist as a string, or its number for unknown values
+func (v BrakingAssist) MarshalJSON() ([]byte, error) {
+	if name, ok := brakingAssistNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the BrakingAssist from its name as a string, or its number
+func (v *BrakingAssist) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var gearboxAssistNames = map[GearboxAssist]string{
+	1: "manual",
+	2: "manual_suggested_gear",
+	3: "auto",
+}
+
+var gearboxAssistValues = map[string]GearboxAssist{
+	"manual":                1,
+	"manual_suggested_gear": 2,
+	"auto":                  3,
+}
+
+// String name of the GearboxAssist, or the type and number for unknown values
+func (v GearboxAssist) String() string {
+	if name, ok := gearboxAssistNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("GearboxAssist(%d)", v)
+}
+
+// MarshalText name of the GearboxAssist, or the number for unknown values
+func (v GearboxAssist) MarshalText() ([]byte, error) {
+	if name, ok := gearboxAssistNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the GearboxAssist from its name or number
+func (v *GearboxAssist) UnmarshalText(text []byte) error {
+	if val, ok := gearboxAssistValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown GearboxAssist %q", text)
+	}
+	*v = GearboxAssist(n)
+	return nil
+}
+
+// MarshalJSON name of the GearboxAssist as a string, or its number for unknown values
+func (v GearboxAssist) MarshalJSON() ([]byte, error) {
+	if name, ok := gearboxAssistNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the GearboxAssist from its name as a string, or its number
+func (v *GearboxAssist) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var dynamicRacingLineNames = map[DynamicRacingLine]string{
+	0: "off",
+	1: "corners_only",
+	2: "full",
+}
+
+var dynamicRacingLineValues = map[string]DynamicRacingLine{
+	"off":          0,
+	"corners_only": 1,
+	"full":         2,
+}
+
+// String name of the DynamicRacingLine, or the type and number for unknown values
+func (v DynamicRacingLine) String() string {
+	if name, ok := dynamicRacingLineNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("DynamicRacingLine(%d)", v)
+}
+
+// MarshalText name of the DynamicRacingLine, or the number for unknown values
+func (v DynamicRacingLine) MarshalText() ([]byte, error) {
+	if name, ok := dynamicRacingLineNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the DynamicRacingLine from its name or number
+func (v *DynamicRacingLine) UnmarshalText(text []byte) error {
+	if val, ok := dynamicRacingLineValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown DynamicRacingLine %q", text)
+	}
+	*v = DynamicRacingLine(n)
+	return nil
+}
+
+// MarshalJSON name of the DynamicRacingLine as a string, or its number for unknown values
+func (v DynamicRacingLine) MarshalJSON() ([]byte, error) {
+	if name, ok := dynamicRacingLineNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the DynamicRacingLine from its name as a string, or its number
+func (v *DynamicRacingLine) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var dynamicRacingLineTypeNames = map[DynamicRacingLineType]string{
+	0: "2d",
+	1: "3d",
+}
+
+var dynamicRacingLineTypeValues = map[string]DynamicRacingLineType{
+	"2d": 0,
+	"3d": 1,
+}
+
+// String name of the DynamicRacingLineType, or the type and number for unknown values
+func (v DynamicRacingLineType) String() string {
+	if name, ok := dynamicRacingLineTypeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("DynamicRacingLineType(%d)", v)
+}
+
+// MarshalText name of the DynamicRacingLineType, or the number for unknown values
+func (v DynamicRacingLineType) MarshalText() ([]byte, error) {
+	if name, ok := dynamicRacingLineTypeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the DynamicRacingLineType from its name or number
+func (v *DynamicRacingLineType) UnmarshalText(text []byte) error {
+	if val, ok := dynamicRacingLineTypeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown DynamicRacingLineType %q", text)
+	}
+	*v = DynamicRacingLineType(n)
+	return nil
+}
+
+// MarshalJSON name of the DynamicRacingLineType as a string, or its number for unknown values
+func (v DynamicRacingLineType) MarshalJSON() ([]byte, error) {
+	if name, ok := dynamicRacingLineTypeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the DynamicRacingLineType from its name as a string, or its number
+func (v *DynamicRacingLineType) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var gameModeNames = map[GameMode]string{
+	0:   "event_mode",
+	3:   "grand_prix",
+	5:   "time_trial",
+	6:   "splitscreen",
+	7:   "online_custom",
+	8:   "online_league",
+	11:  "career_invitational",
+	12:  "championship_invitational",
+	13:  "championship",
+	14:  "online_championship",
+	15:  "online_weekly_event",
+	19:  "career_22",
+	20:  "career_22_online",
+	127: "benchmark",
+}
+
+var gameModeValues = map[string]GameMode{
+	"event_mode":                0,
+	"grand_prix":                3,
+	"time_trial":                5,
+	"splitscreen":               6,
+	"online_custom":             7,
+	"online_league":             8,
+	"career_invitational":       11,
+	"championship_invitational": 12,
+	"championship":              13,
+	"online_championship":       14,
+	"online_weekly_event":       15,
+	"career_22":                 19,
+	"career_22_online":          20,
+	"benchmark":                 127,
+}
+
+// String name of the GameMode, or the type and number for unknown values
+func (v GameMode) String() string {
+	if name, ok := gameModeNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("GameMode(%d)", v)
+}
+
+// MarshalText name of the GameMode, or the number for unknown values
+func (v GameMode) MarshalText() ([]byte, error) {
+	if name, ok := gameModeNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the GameMode from its name or number
+func (v *GameMode) UnmarshalText(text []byte) error {
+	if val, ok := gameModeValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown GameMode %q", text)
+	}
+	*v = GameMode(n)
+	return nil
+}
+
+// MarshalJSON name of the GameMode as a string, or its number for unknown values
+func (v GameMode) MarshalJSON() ([]byte, error) {
+	if name, ok := gameModeNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the GameMode from its name as a string, or its number
+func (v *GameMode) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var ruleSetNames = map[RuleSet]string{
+	0:  "practice_and_qualifying",
+	1:  "race",
+	2:  "time_trial",
+	4:  "time_attack",
+	6:  "checkpoint_challenge",
+	8:  "autocross",
+	9:  "drift",
+	10: "average_speed_zone",
+	11: "rival_duel",
+}
+
+var ruleSetValues = map[string]RuleSet{
+	"practice_and_qualifying": 0,
+	"race":                    1,
+	"time_trial":              2,
+	"time_attack":             4,
+	"checkpoint_challenge":    6,
+	"autocross":               8,
+	"drift":                   9,
+	"average_speed_zone":      10,
+	"rival_duel":              11,
+}
+
+// String name of the RuleSet, or the type and number for unknown values
+func (v RuleSet) String() string {
+	if name, ok := ruleSetNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("RuleSet(%d)", v)
+}
+
+// MarshalText name of the RuleSet, or the number for unknown values
+func (v RuleSet) MarshalText() ([]byte, error) {
+	if name, ok := ruleSetNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the RuleSet from its name or number
+func (v *RuleSet) UnmarshalText(text []byte) error {
+	if val, ok := ruleSetValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown RuleSet %q", text)
+	}
+	*v = RuleSet(n)
+	return nil
+}
+
+// MarshalJSON name of the RuleSet as a string, or its number for unknown values
+func (v RuleSet) MarshalJSON() ([]byte, error) {
+	if name, ok := ruleSetNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the RuleSet from its name as a string, or its number
+func (v *RuleSet) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
+
+var sessionLengthNames = map[SessionLength]string{
+	0: "none",
+	2: "very_short",
+	3: "short",
+	4: "medium",
+	5: "medium_long",
+	6: "long",
+	7: "full",
+}
+
+var sessionLengthValues = map[string]SessionLength{
+	"none":        0,
+	"very_short":  2,
+	"short":       3,
+	"medium":      4,
+	"medium_long": 5,
+	"long":        6,
+	"full":        7,
+}
+
+// String name of the SessionLength, or the type and number for unknown values
+func (v SessionLength) String() string {
+	if name, ok := sessionLengthNames[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("SessionLength(%d)", v)
+}
+
+// MarshalText name of the SessionLength, or the number for unknown values
+func (v SessionLength) MarshalText() ([]byte, error) {
+	if name, ok := sessionLengthNames[v]; ok {
+		return []byte(name), nil
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalText set the SessionLength from its name or number
+func (v *SessionLength) UnmarshalText(text []byte) error {
+	if val, ok := sessionLengthValues[string(text)]; ok {
+		*v = val
+		return nil
+	}
+	n, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return fmt.Errorf("unknown SessionLength %q", text)
+	}
+	*v = SessionLength(n)
+	return nil
+}
+
+// MarshalJSON name of the SessionLength as a string, or its number for unknown values
+func (v SessionLength) MarshalJSON() ([]byte, error) {
+	if name, ok := sessionLengthNames[v]; ok {
+		return json.Marshal(name)
+	}
+	return []byte(strconv.FormatUint(uint64(v), 10)), nil
+}
+
+// UnmarshalJSON set the SessionLength from its name as a string, or its number
+func (v *SessionLength) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return v.UnmarshalText([]byte(text))
+	}
+	return v.UnmarshalText(data)
+}
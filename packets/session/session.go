@@ -1,7 +1,9 @@
 package session
 
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
 import (
-	"github.com/roryphillips/f1-telemetry-client/internal/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
 )
 
 // Packet session data
@@ -42,12 +44,19 @@ type Packet struct {
 	NumMarshalZones uint8 `json:"num_marshal_zones" packet:"15"`
 	// MarshalZones list of marshal zones - max 21
 	MarshalZones [21]MarshalZone `json:"marshal_zones" packet:"16"`
+	// SafetyCarStatus status of the safety car
+	SafetyCarStatus SafetyCarStatus `json:"safety_car_status" packet:"17"`
 	// NetworkGame whether the game is online (true) or offline
-	NetworkGame bool `json:"network_game" packet:"17"`
+	NetworkGame bool `json:"network_game" packet:"18"`
 	// NumWeatherForecastSamples number of forecast samples
-	NumWeatherForecastSamples uint8 `json:"num_weather_forecast_samples" packet:"18"`
+	NumWeatherForecastSamples uint8 `json:"num_weather_forecast_samples" packet:"19"`
 	// WeatherForecastSamples list of forecast samples - max 20
-	WeatherForecastSamples [20]WeatherForecastSample `json:"weather_forecast_samples" packet:"19"`
+	WeatherForecastSamples [20]WeatherForecastSample `json:"weather_forecast_samples" packet:"20"`
+}
+
+// TrackID the track the session takes place at
+func (p *Packet) TrackID() TrackType {
+	return p.Track
 }
 
 // MarshalZone marshal zone data
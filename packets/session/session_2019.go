@@ -0,0 +1,54 @@
+package session
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet2019 F1 2019 session data
+type Packet2019 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// Weather current weather
+	Weather WeatherType `json:"weather" packet:"0"`
+	// TrackTemperature in degrees celsius
+	TrackTemperature int8 `json:"track_temperature" packet:"1"`
+	// AirTemperature in degrees celsius
+	AirTemperature int8 `json:"air_temperature" packet:"2"`
+	// TotalLaps total number of laps in this race
+	TotalLaps uint8 `json:"total_laps" packet:"3"`
+	// TrackLength track length in metres
+	TrackLength uint16 `json:"track_length" packet:"4"`
+	// Session type of the current session
+	Session SessionType `json:"session" packet:"5"`
+	// Track track this session is in
+	Track TrackType `json:"track" packet:"6"`
+	// Formula formula this session uses
+	Formula FormulaType `json:"formula" packet:"7"`
+	// SessionTimeLeft time (in seconds) remaining for this session
+	SessionTimeLeft uint16 `json:"session_time_left" packet:"8"`
+	// SessionDuration time (in seconds) this session lasts for
+	SessionDuration uint16 `json:"session_duration" packet:"9"`
+	// PitSpeedLimit limit (in km/h) for the pit lane
+	PitSpeedLimit uint8 `json:"pit_speed_limit" packet:"10"`
+	// GamePaused whether the game is paused
+	GamePaused bool `json:"game_paused" packet:"11"`
+	// IsSpectating whether the player is spectating
+	IsSpectating bool `json:"is_spectating" packet:"12"`
+	// SpectatorCarIndex index of the car being spectated
+	SpectatorCarIndex uint8 `json:"spectator_car_index" packet:"13"`
+	// SLIProNativeSupport whether SLI pro is supported
+	SLIProNativeSupport bool `json:"sli_pro_native_support" packet:"14"`
+	// NumMarshalZones number of marshal zones to follow
+	NumMarshalZones uint8 `json:"num_marshal_zones" packet:"15"`
+	// MarshalZones list of marshal zones - max 21
+	MarshalZones [21]MarshalZone `json:"marshal_zones" packet:"16"`
+	// SafetyCarStatus status of the safety car
+	SafetyCarStatus SafetyCarStatus `json:"safety_car_status" packet:"17"`
+	// NetworkGame whether the game is online (true) or offline
+	NetworkGame bool `json:"network_game" packet:"18"`
+}
+
+// TrackID the track the session takes place at
+func (p *Packet2019) TrackID() TrackType {
+	return p.Track
+}
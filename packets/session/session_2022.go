@@ -0,0 +1,100 @@
+package session
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet2022 F1 2022 session data
+type Packet2022 struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// Weather current weather
+	Weather WeatherType `json:"weather" packet:"0"`
+	// TrackTemperature in degrees celsius
+	TrackTemperature int8 `json:"track_temperature" packet:"1"`
+	// AirTemperature in degrees celsius
+	AirTemperature int8 `json:"air_temperature" packet:"2"`
+	// TotalLaps total number of laps in this race
+	TotalLaps uint8 `json:"total_laps" packet:"3"`
+	// TrackLength track length in metres
+	TrackLength uint16 `json:"track_length" packet:"4"`
+	// Session type of the current session
+	Session SessionType `json:"session" packet:"5"`
+	// Track track this session is in
+	Track TrackType `json:"track" packet:"6"`
+	// Formula formula this session uses
+	Formula FormulaType `json:"formula" packet:"7"`
+	// SessionTimeLeft time (in seconds) remaining for this session
+	SessionTimeLeft uint16 `json:"session_time_left" packet:"8"`
+	// SessionDuration time (in seconds) this session lasts for
+	SessionDuration uint16 `json:"session_duration" packet:"9"`
+	// PitSpeedLimit limit (in km/h) for the pit lane
+	PitSpeedLimit uint8 `json:"pit_speed_limit" packet:"10"`
+	// GamePaused whether the game is paused
+	GamePaused bool `json:"game_paused" packet:"11"`
+	// IsSpectating whether the player is spectating
+	IsSpectating bool `json:"is_spectating" packet:"12"`
+	// SpectatorCarIndex index of the car being spectated
+	SpectatorCarIndex uint8 `json:"spectator_car_index" packet:"13"`
+	// SLIProNativeSupport whether SLI pro is supported
+	SLIProNativeSupport bool `json:"sli_pro_native_support" packet:"14"`
+	// NumMarshalZones number of marshal zones to follow
+	NumMarshalZones uint8 `json:"num_marshal_zones" packet:"15"`
+	// MarshalZones list of marshal zones - max 21
+	MarshalZones [21]MarshalZone `json:"marshal_zones" packet:"16"`
+	// SafetyCarStatus status of the safety car
+	SafetyCarStatus SafetyCarStatus `json:"safety_car_status" packet:"17"`
+	// NetworkGame whether the game is online (true) or offline
+	NetworkGame bool `json:"network_game" packet:"18"`
+	// NumWeatherForecastSamples number of forecast samples
+	NumWeatherForecastSamples uint8 `json:"num_weather_forecast_samples" packet:"19"`
+	// WeatherForecastSamples list of forecast samples - max 56
+	WeatherForecastSamples [56]WeatherForecastSample2021 `json:"weather_forecast_samples" packet:"20"`
+	// ForecastAccuracy accuracy of the weather forecast
+	ForecastAccuracy ForecastAccuracy `json:"forecast_accuracy" packet:"21"`
+	// AIDifficulty AI difficulty rating (0-110)
+	AIDifficulty uint8 `json:"ai_difficulty" packet:"22"`
+	// SeasonLinkIdentifier identifier for the season, persists across saves
+	SeasonLinkIdentifier uint32 `json:"season_link_identifier" packet:"23"`
+	// WeekendLinkIdentifier identifier for the weekend, persists across saves
+	WeekendLinkIdentifier uint32 `json:"weekend_link_identifier" packet:"24"`
+	// SessionLinkIdentifier identifier for the session, persists across saves
+	SessionLinkIdentifier uint32 `json:"session_link_identifier" packet:"25"`
+	// PitStopWindowIdealLap ideal lap to pit on for the current strategy (player)
+	PitStopWindowIdealLap uint8 `json:"pit_stop_window_ideal_lap" packet:"26"`
+	// PitStopWindowLatestLap latest lap to pit on for the current strategy (player)
+	PitStopWindowLatestLap uint8 `json:"pit_stop_window_latest_lap" packet:"27"`
+	// PitStopRejoinPosition predicted position to rejoin at (player)
+	PitStopRejoinPosition uint8 `json:"pit_stop_rejoin_position" packet:"28"`
+	// SteeringAssist whether the steering assist is on
+	SteeringAssist bool `json:"steering_assist" packet:"29"`
+	// BrakingAssist braking assist level
+	BrakingAssist BrakingAssist `json:"braking_assist" packet:"30"`
+	// GearboxAssist gearbox assist level
+	GearboxAssist GearboxAssist `json:"gearbox_assist" packet:"31"`
+	// PitAssist whether the pit assist is on
+	PitAssist bool `json:"pit_assist" packet:"32"`
+	// PitReleaseAssist whether the pit release assist is on
+	PitReleaseAssist bool `json:"pit_release_assist" packet:"33"`
+	// ERSAssist whether the ERS assist is on
+	ERSAssist bool `json:"ers_assist" packet:"34"`
+	// DRSAssist whether the DRS assist is on
+	DRSAssist bool `json:"drs_assist" packet:"35"`
+	// DynamicRacingLine dynamic racing line assist
+	DynamicRacingLine DynamicRacingLine `json:"dynamic_racing_line" packet:"36"`
+	// DynamicRacingLineType how the dynamic racing line is drawn
+	DynamicRacingLineType DynamicRacingLineType `json:"dynamic_racing_line_type" packet:"37"`
+	// GameMode game mode being played
+	GameMode GameMode `json:"game_mode" packet:"38"`
+	// RuleSet rules in use for the session
+	RuleSet RuleSet `json:"rule_set" packet:"39"`
+	// TimeOfDay local time of day in minutes since midnight
+	TimeOfDay uint32 `json:"time_of_day" packet:"40"`
+	// SessionLength length of the session
+	SessionLength SessionLength `json:"session_length" packet:"41"`
+}
+
+// TrackID the track the session takes place at
+func (p *Packet2022) TrackID() TrackType {
+	return p.Track
+}
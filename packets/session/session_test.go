@@ -0,0 +1,181 @@
+package session_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+	"testing"
+)
+
+// sessionStart append the fields every format starts with, up to and including NetworkGame
+func sessionStart(b *packettest.Builder) {
+	b.U8(uint8(session.WeatherTypeLightRain)).I8(-3).I8(12).U8(58)
+	b.U16(5303)
+	b.U8(uint8(session.SessionTypeRace1)).I8(int8(session.TrackTypeSilverstone)).U8(uint8(session.FormulaTypeF1Modern))
+	b.U16(3600).U16(7200)
+	b.U8(80).Bool(false).Bool(true).U8(19).Bool(true)
+	b.U8(21)
+	for i := 0; i < 21; i++ {
+		b.F32(float32(i) / 21).I8(int8(i%5 - 1))
+	}
+	b.U8(uint8(session.SafetyCarStatusVirtual)).Bool(true)
+}
+
+// checkStart whether a packet holds the values appended by sessionStart, for fields every format has
+func checkStart(t *testing.T, name string, weather session.WeatherType, trackTemperature int8, totalLaps uint8, trackLength uint16,
+	track session.TrackType, spectatorCarIndex uint8, zones [21]session.MarshalZone, safetyCar session.SafetyCarStatus, network bool) {
+	t.Helper()
+	if weather != session.WeatherTypeLightRain || trackTemperature != -3 || totalLaps != 58 || trackLength != 5303 {
+		t.Errorf("%v: weather %v, track temperature %v, %v laps of %vm", name, weather, trackTemperature, totalLaps, trackLength)
+	}
+	if track != session.TrackTypeSilverstone || spectatorCarIndex != 19 {
+		t.Errorf("%v: track %v, spectating car %v", name, track, spectatorCarIndex)
+	}
+	for i, zone := range zones {
+		if zone.ZoneStart != float32(i)/21 || zone.ZoneFlag != session.ZoneFlag(i%5-1) {
+			t.Errorf("%v: marshal zone %v is %+v", name, i, zone)
+		}
+	}
+	if safetyCar != session.SafetyCarStatusVirtual || !network {
+		t.Errorf("%v: safety car %v, network game %v", name, safetyCar, network)
+	}
+}
+
+// forecast2021 append the 2021 weather forecast samples and the fields between them and the assists
+func forecast2021(b *packettest.Builder) {
+	b.U8(56)
+	for i := 0; i < 56; i++ {
+		b.U8(uint8(session.SessionTypeQualifying1)).U8(uint8(i)).U8(uint8(session.WeatherTypeOvercast)).
+			I8(int8(20 + i%3)).I8(1).I8(int8(10 + i%4)).I8(2).U8(uint8(i))
+	}
+	b.U8(uint8(session.ForecastAccuracyApproximate)).U8(95)
+	b.U32(0x01020304).U32(0x05060708).U32(0x090a0b0c)
+	b.U8(17).U8(23).U8(11)
+	b.Bool(true).U8(uint8(session.BrakingAssistHigh)).U8(uint8(session.GearboxAssistManualSuggestedGear))
+	b.Bool(false).Bool(true).Bool(false).Bool(true)
+	b.U8(uint8(session.DynamicRacingLineFull)).U8(uint8(session.DynamicRacingLineType3D))
+}
+
+// checkForecast2021 whether the 2021 packet fields hold the values appended by forecast2021
+func checkForecast2021(t *testing.T, name string, p *session.Packet2021) {
+	t.Helper()
+	if p.NumWeatherForecastSamples != 56 {
+		t.Errorf("%v: %v weather forecast samples", name, p.NumWeatherForecastSamples)
+	}
+	for i, sample := range p.WeatherForecastSamples {
+		want := session.WeatherForecastSample2021{
+			Session:                session.SessionTypeQualifying1,
+			TimeOffset:             uint8(i),
+			Weather:                session.WeatherTypeOvercast,
+			TrackTemperature:       int8(20 + i%3),
+			TrackTemperatureChange: 1,
+			AirTemperature:         int8(10 + i%4),
+			AirTemperatureChange:   2,
+			RainPercentage:         uint8(i),
+		}
+		if sample != want {
+			t.Errorf("%v: forecast sample %v is %+v, want %+v", name, i, sample, want)
+		}
+	}
+	if p.ForecastAccuracy != session.ForecastAccuracyApproximate || p.AIDifficulty != 95 {
+		t.Errorf("%v: forecast accuracy %v, AI difficulty %v", name, p.ForecastAccuracy, p.AIDifficulty)
+	}
+	if p.SeasonLinkIdentifier != 0x01020304 || p.WeekendLinkIdentifier != 0x05060708 || p.SessionLinkIdentifier != 0x090a0b0c {
+		t.Errorf("%v: link identifiers %#x, %#x, %#x", name, p.SeasonLinkIdentifier, p.WeekendLinkIdentifier, p.SessionLinkIdentifier)
+	}
+	if p.PitStopWindowIdealLap != 17 || p.PitStopWindowLatestLap != 23 || p.PitStopRejoinPosition != 11 {
+		t.Errorf("%v: pit stop window %v to %v, rejoining %v", name, p.PitStopWindowIdealLap, p.PitStopWindowLatestLap, p.PitStopRejoinPosition)
+	}
+	if !p.SteeringAssist || p.BrakingAssist != session.BrakingAssistHigh || p.GearboxAssist != session.GearboxAssistManualSuggestedGear {
+		t.Errorf("%v: steering assist %v, braking assist %v, gearbox assist %v", name, p.SteeringAssist, p.BrakingAssist, p.GearboxAssist)
+	}
+	if p.PitAssist || !p.PitReleaseAssist || p.ERSAssist || !p.DRSAssist {
+		t.Errorf("%v: pit assist %v, pit release assist %v, ERS assist %v, DRS assist %v", name, p.PitAssist, p.PitReleaseAssist, p.ERSAssist, p.DRSAssist)
+	}
+	if p.DynamicRacingLine != session.DynamicRacingLineFull || p.DynamicRacingLineType != session.DynamicRacingLineType3D {
+		t.Errorf("%v: racing line %v, %v", name, p.DynamicRacingLine, p.DynamicRacingLineType)
+	}
+}
+
+func TestGolden2019(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2019, common.PacketIDSession)
+	b := &packettest.Builder{}
+	sessionStart(b)
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*session.Packet2019)
+	checkStart(t, layout.Name, p.Weather, p.TrackTemperature, p.TotalLaps, p.TrackLength, p.Track, p.SpectatorCarIndex, p.MarshalZones, p.SafetyCarStatus, p.NetworkGame)
+}
+
+func TestGolden2020(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2020, common.PacketIDSession)
+	b := &packettest.Builder{}
+	sessionStart(b)
+	b.U8(20)
+	for i := 0; i < 20; i++ {
+		b.U8(uint8(session.SessionTypeRace1)).U8(uint8(i * 5)).U8(uint8(session.WeatherTypeStorm)).I8(int8(30 - i)).I8(int8(-i))
+	}
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*session.Packet)
+	checkStart(t, layout.Name, p.Weather, p.TrackTemperature, p.TotalLaps, p.TrackLength, p.Track, p.SpectatorCarIndex, p.MarshalZones, p.SafetyCarStatus, p.NetworkGame)
+	if p.NumWeatherForecastSamples != 20 {
+		t.Errorf("%v: %v weather forecast samples", layout.Name, p.NumWeatherForecastSamples)
+	}
+	for i, sample := range p.WeatherForecastSamples {
+		want := session.WeatherForecastSample{
+			Session:          session.SessionTypeRace1,
+			TimeOffset:       uint8(i * 5),
+			Weather:          session.WeatherTypeStorm,
+			TrackTemperature: int8(30 - i),
+			AirTemperature:   int8(-i),
+		}
+		if sample != want {
+			t.Errorf("%v: forecast sample %v is %+v, want %+v", layout.Name, i, sample, want)
+		}
+	}
+}
+
+func TestGolden2021(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2021, common.PacketIDSession)
+	b := &packettest.Builder{}
+	sessionStart(b)
+	forecast2021(b)
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*session.Packet2021)
+	checkStart(t, layout.Name, p.Weather, p.TrackTemperature, p.TotalLaps, p.TrackLength, p.Track, p.SpectatorCarIndex, p.MarshalZones, p.SafetyCarStatus, p.NetworkGame)
+	checkForecast2021(t, layout.Name, p)
+}
+
+func TestGolden2022(t *testing.T) {
+	layout := packettest.LayoutOf(common.PacketFormat2022, common.PacketIDSession)
+	b := &packettest.Builder{}
+	sessionStart(b)
+	forecast2021(b)
+	b.U8(uint8(session.GameModeGrandPrix)).U8(uint8(session.RuleSetRace)).U32(754).U8(uint8(session.SessionLengthMedium))
+
+	p := packettest.Golden(t, layout, b.Bytes()).(*session.Packet2022)
+	checkStart(t, layout.Name, p.Weather, p.TrackTemperature, p.TotalLaps, p.TrackLength, p.Track, p.SpectatorCarIndex, p.MarshalZones, p.SafetyCarStatus, p.NetworkGame)
+	checkForecast2021(t, layout.Name, &session.Packet2021{
+		NumWeatherForecastSamples: p.NumWeatherForecastSamples,
+		WeatherForecastSamples:    p.WeatherForecastSamples,
+		ForecastAccuracy:          p.ForecastAccuracy,
+		AIDifficulty:              p.AIDifficulty,
+		SeasonLinkIdentifier:      p.SeasonLinkIdentifier,
+		WeekendLinkIdentifier:     p.WeekendLinkIdentifier,
+		SessionLinkIdentifier:     p.SessionLinkIdentifier,
+		PitStopWindowIdealLap:     p.PitStopWindowIdealLap,
+		PitStopWindowLatestLap:    p.PitStopWindowLatestLap,
+		PitStopRejoinPosition:     p.PitStopRejoinPosition,
+		SteeringAssist:            p.SteeringAssist,
+		BrakingAssist:             p.BrakingAssist,
+		GearboxAssist:             p.GearboxAssist,
+		PitAssist:                 p.PitAssist,
+		PitReleaseAssist:          p.PitReleaseAssist,
+		ERSAssist:                 p.ERSAssist,
+		DRSAssist:                 p.DRSAssist,
+		DynamicRacingLine:         p.DynamicRacingLine,
+		DynamicRacingLineType:     p.DynamicRacingLineType,
+	})
+	if p.GameMode != session.GameModeGrandPrix || p.RuleSet != session.RuleSetRace || p.TimeOfDay != 754 || p.SessionLength != session.SessionLengthMedium {
+		t.Errorf("%v: game mode %v, rule set %v, time of day %v, session length %v", layout.Name, p.GameMode, p.RuleSet, p.TimeOfDay, p.SessionLength)
+	}
+}
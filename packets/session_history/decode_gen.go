@@ -0,0 +1,97 @@
+// Code generated by packet-decoder-gen. DO NOT EDIT.
+
+package session_history
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets/car_status"
+)
+
+// DecodeFrom decode LapHistoryData from its wire format, returning the number of bytes read
+func (p *LapHistoryData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 11 {
+		return 0, fmt.Errorf("unable to decode LapHistoryData: need 11 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 11, nil
+}
+
+// PacketSize size of LapHistoryData on the wire in bytes
+func (p *LapHistoryData) PacketSize() int {
+	return 11
+}
+
+// decode decode LapHistoryData from data holding at least 11 bytes
+func (p *LapHistoryData) decode(data []byte) error {
+	p.LapTime = uint32(binary.LittleEndian.Uint32(data[0:]))
+	p.Sector1Time = uint16(binary.LittleEndian.Uint16(data[4:]))
+	p.Sector2Time = uint16(binary.LittleEndian.Uint16(data[6:]))
+	p.Sector3Time = uint16(binary.LittleEndian.Uint16(data[8:]))
+	p.LapValid = LapValidFlags(data[10])
+	return nil
+}
+
+// DecodeFrom decode Packet from its wire format, returning the number of bytes read
+func (p *Packet) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 1131 {
+		return 0, fmt.Errorf("unable to decode Packet: need 1131 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 1131, nil
+}
+
+// PacketSize size of Packet on the wire in bytes
+func (p *Packet) PacketSize() int {
+	return 1131
+}
+
+// decode decode Packet from data holding at least 1131 bytes
+func (p *Packet) decode(data []byte) error {
+	p.CarIdx = uint8(data[0])
+	p.NumLaps = uint8(data[1])
+	p.NumTyreStints = uint8(data[2])
+	p.BestLapTimeLapNum = uint8(data[3])
+	p.BestSector1LapNum = uint8(data[4])
+	p.BestSector2LapNum = uint8(data[5])
+	p.BestSector3LapNum = uint8(data[6])
+	for i0 := range p.LapHistory {
+		if err := p.LapHistory[i0].decode(data[7+i0*11:]); err != nil {
+			return fmt.Errorf("unable to set struct field LapHistory item: %v", err)
+		}
+	}
+	for i0 := range p.TyreStintsHistory {
+		if err := p.TyreStintsHistory[i0].decode(data[1107+i0*3:]); err != nil {
+			return fmt.Errorf("unable to set struct field TyreStintsHistory item: %v", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decode TyreStintHistoryData from its wire format, returning the number of bytes read
+func (p *TyreStintHistoryData) DecodeFrom(data []byte) (int, error) {
+	if len(data) < 3 {
+		return 0, fmt.Errorf("unable to decode TyreStintHistoryData: need 3 bytes, have %v", len(data))
+	}
+	if err := p.decode(data); err != nil {
+		return 0, err
+	}
+	return 3, nil
+}
+
+// PacketSize size of TyreStintHistoryData on the wire in bytes
+func (p *TyreStintHistoryData) PacketSize() int {
+	return 3
+}
+
+// decode decode TyreStintHistoryData from data holding at least 3 bytes
+func (p *TyreStintHistoryData) decode(data []byte) error {
+	p.EndLap = uint8(data[0])
+	p.TyreActualCompound = car_status.ActualTyreCompound(data[1])
+	p.TyreVisualCompound = car_status.VisualTyreCompound(data[2])
+	return nil
+}
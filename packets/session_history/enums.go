@@ -0,0 +1,20 @@
+package session_history
+
+// LapValidFlags bit flags of which parts of a lap are valid
+type LapValidFlags uint8
+
+const (
+	// LapValidFlagsLap lap valid
+	LapValidFlagsLap LapValidFlags = 0x01
+	// LapValidFlagsSector1 sector 1 valid
+	LapValidFlagsSector1 LapValidFlags = 0x02
+	// LapValidFlagsSector2 sector 2 valid
+	LapValidFlagsSector2 LapValidFlags = 0x04
+	// LapValidFlagsSector3 sector 3 valid
+	LapValidFlagsSector3 LapValidFlags = 0x08
+)
+
+// Has whether the given part of the lap is valid
+func (l LapValidFlags) Has(flag LapValidFlags) bool {
+	return l&flag == flag
+}
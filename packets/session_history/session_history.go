@@ -0,0 +1,56 @@
+package session_history
+
+//go:generate go run github.com/roryphillips/f1-telemetry-client/cmd/packet-decoder-gen
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/car_status"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// Packet session history data for a single car, sent from F1 2021 onwards
+type Packet struct {
+	// Header packet header
+	Header common.Header `json:"header"`
+	// CarIdx index of the car this history relates to
+	CarIdx uint8 `json:"car_idx" packet:"0"`
+	// NumLaps number of laps in the data, including the current partial lap
+	NumLaps uint8 `json:"num_laps" packet:"1"`
+	// NumTyreStints number of tyre stints in the data
+	NumTyreStints uint8 `json:"num_tyre_stints" packet:"2"`
+	// BestLapTimeLapNum lap the best lap time was achieved on
+	BestLapTimeLapNum uint8 `json:"best_lap_time_lap_num" packet:"3"`
+	// BestSector1LapNum lap the best sector 1 time was achieved on
+	BestSector1LapNum uint8 `json:"best_sector1_lap_num" packet:"4"`
+	// BestSector2LapNum lap the best sector 2 time was achieved on
+	BestSector2LapNum uint8 `json:"best_sector2_lap_num" packet:"5"`
+	// BestSector3LapNum lap the best sector 3 time was achieved on
+	BestSector3LapNum uint8 `json:"best_sector3_lap_num" packet:"6"`
+	// LapHistory history for each lap - max 100
+	LapHistory [100]LapHistoryData `json:"lap_history" packet:"7"`
+	// TyreStintsHistory history for each tyre stint - max 8
+	TyreStintsHistory [8]TyreStintHistoryData `json:"tyre_stints_history" packet:"8"`
+}
+
+// LapHistoryData history for a single lap
+type LapHistoryData struct {
+	// LapTime lap time in milliseconds
+	LapTime uint32 `json:"lap_time" packet:"0"`
+	// Sector1Time sector 1 time in milliseconds
+	Sector1Time uint16 `json:"sector1_time" packet:"1"`
+	// Sector2Time sector 2 time in milliseconds
+	Sector2Time uint16 `json:"sector2_time" packet:"2"`
+	// Sector3Time sector 3 time in milliseconds
+	Sector3Time uint16 `json:"sector3_time" packet:"3"`
+	// LapValid which parts of the lap are valid
+	LapValid LapValidFlags `json:"lap_valid" packet:"4"`
+}
+
+// TyreStintHistoryData history for a single tyre stint
+type TyreStintHistoryData struct {
+	// EndLap lap the stint ended on, 255 if the current stint
+	EndLap uint8 `json:"end_lap" packet:"0"`
+	// TyreActualCompound actual compound used for the stint
+	TyreActualCompound car_status.ActualTyreCompound `json:"tyre_actual_compound" packet:"1"`
+	// TyreVisualCompound visual compound used for the stint
+	TyreVisualCompound car_status.VisualTyreCompound `json:"tyre_visual_compound" packet:"2"`
+}
@@ -0,0 +1,55 @@
+package session_history_test
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/car_status"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"github.com/roryphillips/f1-telemetry-client/packets/session_history"
+	"testing"
+)
+
+func TestGolden(t *testing.T) {
+	valid := session_history.LapValidFlagsLap | session_history.LapValidFlagsSector1 | session_history.LapValidFlagsSector3
+	for _, format := range []uint16{common.PacketFormat2021, common.PacketFormat2022} {
+		layout := packettest.LayoutOf(format, common.PacketIDSessionHistory)
+		b := &packettest.Builder{}
+		b.U8(19).U8(57).U8(3).U8(41).U8(12).U8(41).U8(56)
+		for lap := 0; lap < 100; lap++ {
+			b.U32(uint32(90000 + lap)).U16(uint16(28000 + lap)).U16(uint16(33000 + lap)).U16(uint16(29000 + lap)).U8(uint8(valid))
+		}
+		for stint := 0; stint < 8; stint++ {
+			b.U8(uint8(stint*20 + 15)).U8(uint8(car_status.ActualTyreCompoundC4)).U8(uint8(car_status.VisualTyreCompoundSoft))
+		}
+
+		p := packettest.Golden(t, layout, b.Bytes()).(*session_history.Packet)
+		if p.CarIdx != 19 || p.NumLaps != 57 || p.NumTyreStints != 3 {
+			t.Errorf("%v: car %v, %v laps, %v tyre stints", layout.Name, p.CarIdx, p.NumLaps, p.NumTyreStints)
+		}
+		if p.BestLapTimeLapNum != 41 || p.BestSector1LapNum != 12 || p.BestSector2LapNum != 41 || p.BestSector3LapNum != 56 {
+			t.Errorf("%v: best lap %v, best sectors %v, %v, %v",
+				layout.Name, p.BestLapTimeLapNum, p.BestSector1LapNum, p.BestSector2LapNum, p.BestSector3LapNum)
+		}
+		for lap, history := range p.LapHistory {
+			want := session_history.LapHistoryData{
+				LapTime:     uint32(90000 + lap),
+				Sector1Time: uint16(28000 + lap),
+				Sector2Time: uint16(33000 + lap),
+				Sector3Time: uint16(29000 + lap),
+				LapValid:    valid,
+			}
+			if history != want {
+				t.Errorf("%v: lap %v is %+v, want %+v", layout.Name, lap, history, want)
+			}
+		}
+		for stint, history := range p.TyreStintsHistory {
+			want := session_history.TyreStintHistoryData{
+				EndLap:             uint8(stint*20 + 15),
+				TyreActualCompound: car_status.ActualTyreCompoundC4,
+				TyreVisualCompound: car_status.VisualTyreCompoundSoft,
+			}
+			if history != want {
+				t.Errorf("%v: tyre stint %v is %+v, want %+v", layout.Name, stint, history, want)
+			}
+		}
+	}
+}
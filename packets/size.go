@@ -0,0 +1,82 @@
+package packets
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// PacketSizer implemented by packets which know their size on the wire, generated by packet-decoder-gen
+type PacketSizer interface {
+	// PacketSize size on the wire in bytes
+	PacketSize() int
+}
+
+var (
+	sizes     = make(map[reflect.Type]int)
+	sizesLock = &sync.Mutex{}
+)
+
+// SizeOf size of a packet struct, or ptr to a struct, on the wire in bytes.
+// Uses PacketSize when implemented, otherwise the size is computed from the packet tags.
+func SizeOf(src interface{}) (int, error) {
+	if sizer, ok := src.(PacketSizer); ok {
+		return sizer.PacketSize(), nil
+	}
+
+	t := reflect.Indirect(reflect.ValueOf(src)).Type()
+	if t.Kind() != reflect.Struct {
+		return 0, fmt.Errorf("must pass a struct type to be sized")
+	}
+
+	sizesLock.Lock()
+	defer sizesLock.Unlock()
+
+	if size, ok := sizes[t]; ok {
+		return size, nil
+	}
+	size, err := sizeOfType(t, 0)
+	if err != nil {
+		return 0, err
+	}
+	sizes[t] = size
+	return size, nil
+}
+
+// sizeOfType size of a type on the wire in bytes
+func sizeOfType(t reflect.Type, length int) (int, error) {
+	switch t.Kind() {
+	case reflect.Struct:
+		fields, err := generateSortedFields(reflect.New(t).Elem())
+		if err != nil {
+			return 0, fmt.Errorf("failed to generate fields: %v", err)
+		}
+		total := 0
+		for _, field := range fields {
+			f, _ := t.FieldByName(field.name)
+			size, err := sizeOfType(f.Type, field.length)
+			if err != nil {
+				return 0, fmt.Errorf("unable to size %v: %v", field.name, err)
+			}
+			total += size
+		}
+		return total, nil
+	case reflect.Array:
+		size, err := sizeOfType(t.Elem(), length)
+		return t.Len() * size, err
+	case reflect.String:
+		if length <= 0 {
+			return 0, fmt.Errorf("string fields must specify a length")
+		}
+		return length, nil
+	case reflect.Bool, reflect.Uint8, reflect.Int8:
+		return 1, nil
+	case reflect.Uint16, reflect.Int16:
+		return 2, nil
+	case reflect.Uint32, reflect.Int32, reflect.Float32:
+		return 4, nil
+	case reflect.Uint64, reflect.Int64, reflect.Float64:
+		return 8, nil
+	}
+	return 0, fmt.Errorf("unsupported type %v", t)
+}
@@ -0,0 +1,168 @@
+package packets
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// PacketWriter handler to be able to write structs into packets
+type PacketWriter interface {
+	Encode(src interface{}) ([]byte, error)
+}
+
+// PacketMarshaler implemented by types which cannot be described by packet tags alone,
+// the inverse of PacketUnmarshaler
+type PacketMarshaler interface {
+	MarshalPacket(writer PacketWriter) ([]byte, error)
+}
+
+type packetWriter struct {
+	reflections map[string][]packetField
+	lock        *sync.Mutex
+}
+
+// NewPacketWriter creates a new packet writer
+func NewPacketWriter() PacketWriter {
+	return &packetWriter{
+		reflections: make(map[string][]packetField),
+		lock:        &sync.Mutex{},
+	}
+}
+
+// Encode taking a struct, or ptr to a struct, and writing its packet fields in little endian wire format
+func (w *packetWriter) Encode(src interface{}) ([]byte, error) {
+	if marshaler, ok := src.(PacketMarshaler); ok {
+		return marshaler.MarshalPacket(w)
+	}
+
+	val := reflect.Indirect(reflect.ValueOf(src))
+	if val.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("must pass a struct type into the reflection writer")
+	}
+
+	return w.writeStruct(nil, val)
+}
+
+// inspect parse out the fields of the struct to write into the packet
+func (w *packetWriter) inspect(val reflect.Value) ([]packetField, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	structName := val.Type().String()
+	cached, hasCached := w.reflections[structName]
+	if hasCached {
+		return cached, nil
+	}
+
+	fields, err := generateSortedFields(val)
+	if err != nil {
+		return fields, fmt.Errorf("failed to generate fields: %v", err)
+	}
+
+	w.reflections[structName] = fields
+	return fields, nil
+}
+
+// writeVal append the value of a given field to the packet
+func (w *packetWriter) writeVal(out []byte, f reflect.Value, field packetField) ([]byte, error) {
+	kind := f.Kind()
+	switch kind {
+	case reflect.Struct:
+		return w.writeStruct(out, f)
+	case reflect.Array:
+		return w.writeArray(out, f, field)
+	case reflect.String:
+		return w.writeString(out, f, field.length)
+	case reflect.Bool:
+		if f.Bool() {
+			return append(out, 1), nil
+		}
+		return append(out, 0), nil
+	case reflect.Float32:
+		return w.writeUint32(out, math.Float32bits(float32(f.Float()))), nil
+	case reflect.Float64:
+		return w.writeUint64(out, math.Float64bits(f.Float())), nil
+	case reflect.Uint64:
+		return w.writeUint64(out, f.Uint()), nil
+	case reflect.Uint32:
+		return w.writeUint32(out, uint32(f.Uint())), nil
+	case reflect.Uint16:
+		return w.writeUint16(out, uint16(f.Uint())), nil
+	case reflect.Uint8:
+		return append(out, uint8(f.Uint())), nil
+	case reflect.Int64:
+		return w.writeUint64(out, uint64(f.Int())), nil
+	case reflect.Int32:
+		return w.writeUint32(out, uint32(f.Int())), nil
+	case reflect.Int16:
+		return w.writeUint16(out, uint16(f.Int())), nil
+	case reflect.Int8:
+		return append(out, uint8(f.Int())), nil
+	}
+	return out, fmt.Errorf("unsupported type %v", kind.String())
+}
+
+// writeStruct append a struct to the packet
+func (w *packetWriter) writeStruct(out []byte, f reflect.Value) ([]byte, error) {
+	fields, err := w.inspect(f)
+	if err != nil {
+		return out, fmt.Errorf("unable to write struct: %v", err)
+	}
+	for _, field := range fields {
+		out, err = w.writeVal(out, f.FieldByName(field.name), field)
+		if err != nil {
+			return out, fmt.Errorf("unable to write struct field %v: %v", field.name, err)
+		}
+	}
+	return out, nil
+}
+
+// writeArray append an array to the packet, each item is written as the field itself
+func (w *packetWriter) writeArray(out []byte, f reflect.Value, field packetField) ([]byte, error) {
+	var err error
+	for i := 0; i < f.Len(); i++ {
+		out, err = w.writeVal(out, f.Index(i), field)
+		if err != nil {
+			return out, fmt.Errorf("unable to write array item %v: %v", i, err)
+		}
+	}
+	return out, nil
+}
+
+// writeString append a fixed length, null padded string to the packet
+func (w *packetWriter) writeString(out []byte, f reflect.Value, length int) ([]byte, error) {
+	if length <= 0 {
+		return out, fmt.Errorf("string fields must specify a length")
+	}
+	str := f.String()
+	// A string filling every byte is written without a null terminator, as the parser reads it
+	if len(str) > length {
+		return out, fmt.Errorf("string of %v bytes does not fit in %v bytes", len(str), length)
+	}
+	out = append(out, str...)
+	return append(out, make([]byte, length-len(str))...), nil
+}
+
+// writeUint64 append a little endian uint64 to the packet
+func (w *packetWriter) writeUint64(out []byte, v uint64) []byte {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	return append(out, b[:]...)
+}
+
+// writeUint32 append a little endian uint32 to the packet
+func (w *packetWriter) writeUint32(out []byte, v uint32) []byte {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	return append(out, b[:]...)
+}
+
+// writeUint16 append a little endian uint16 to the packet
+func (w *packetWriter) writeUint16(out []byte, v uint16) []byte {
+	var b [2]byte
+	binary.LittleEndian.PutUint16(b[:], v)
+	return append(out, b[:]...)
+}
@@ -0,0 +1,231 @@
+package registry
+
+import (
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets"
+	"github.com/roryphillips/f1-telemetry-client/packets/car_damage"
+	"github.com/roryphillips/f1-telemetry-client/packets/car_setups"
+	"github.com/roryphillips/f1-telemetry-client/packets/car_status"
+	"github.com/roryphillips/f1-telemetry-client/packets/car_telemetry"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/event"
+	"github.com/roryphillips/f1-telemetry-client/packets/final_classification"
+	"github.com/roryphillips/f1-telemetry-client/packets/lap_data"
+	"github.com/roryphillips/f1-telemetry-client/packets/lobby_info"
+	"github.com/roryphillips/f1-telemetry-client/packets/motion"
+	"github.com/roryphillips/f1-telemetry-client/packets/participants"
+	"github.com/roryphillips/f1-telemetry-client/packets/session"
+	"github.com/roryphillips/f1-telemetry-client/packets/session_history"
+)
+
+// Default registry containing all of the built in packet types
+var Default = NewDefault(Options{})
+
+// NewDefault creates a new registry containing all of the built in packet types
+func NewDefault(options Options) Registry {
+	r := New(packets.NewPacketParser(), options)
+	registerBuiltins(r)
+	return r
+}
+
+// Register a packet type with the default registry
+func Register(key Key, name string, constructor Constructor) error {
+	return Default.Register(key, name, constructor)
+}
+
+// Lookup the packet type registered with the default registry for a header
+func Lookup(header common.Header) (Entry, bool) {
+	return Default.Lookup(header)
+}
+
+// Decode a packet using the default registry
+func Decode(data []byte) (common.Header, interface{}, error) {
+	return Default.Decode(data)
+}
+
+// DecodeHeader decode only the header of a packet using the default registry
+func DecodeHeader(data []byte) (common.Header, error) {
+	return Default.DecodeHeader(data)
+}
+
+// Encode a packet using the default registry
+func Encode(header common.Header, packet interface{}) ([]byte, error) {
+	return Default.Encode(header, packet)
+}
+
+// registerBuiltins register all of the built in packet formats and types
+func registerBuiltins(r Registry) {
+	mustRegisterFormat(r, common.PacketFormat2019, NewHeader2019)
+	mustRegisterFormat(r, common.PacketFormat2020, NewHeader2020)
+	mustRegisterFormat(r, common.PacketFormat2021, NewHeader2020)
+	mustRegisterFormat(r, common.PacketFormat2022, NewHeader2020)
+	// Only the header layout is known for F1 2023, its packets are reported as unregistered
+	mustRegisterFormat(r, common.PacketFormat2023, NewHeader2023)
+
+	register2019(r)
+	register2020(r)
+	register2021(r)
+	register2022(r)
+}
+
+// register2019 register the F1 2019 packet types
+func register2019(r Registry) {
+	f := common.PacketFormat2019
+	mustRegister(r, Key{f, common.PacketIDMotion, 1}, "motion", func(h common.Header) interface{} {
+		return &motion.Packet2019{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDSession, 1}, "session", func(h common.Header) interface{} {
+		return &session.Packet2019{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDLapData, 1}, "lap_data", func(h common.Header) interface{} {
+		return &lap_data.Packet2019{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDEvent, 1}, "event", func(h common.Header) interface{} {
+		return &event.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDParticipants, 1}, "participants", func(h common.Header) interface{} {
+		return &participants.Packet2019{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarSetups, 1}, "car_setups", func(h common.Header) interface{} {
+		return &car_setups.Packet2019{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarTelemetry, 1}, "car_telemetry", func(h common.Header) interface{} {
+		return &car_telemetry.Packet2019{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarStatus, 1}, "car_status", func(h common.Header) interface{} {
+		return &car_status.Packet2019{Header: h}
+	})
+}
+
+// register2020 register the F1 2020 packet types
+func register2020(r Registry) {
+	f := common.PacketFormat2020
+	mustRegister(r, Key{f, common.PacketIDMotion, 1}, "motion", func(h common.Header) interface{} {
+		return &motion.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDSession, 1}, "session", func(h common.Header) interface{} {
+		return &session.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDLapData, 1}, "lap_data", func(h common.Header) interface{} {
+		return &lap_data.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDEvent, 1}, "event", func(h common.Header) interface{} {
+		return &event.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDParticipants, 1}, "participants", func(h common.Header) interface{} {
+		return &participants.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarSetups, 1}, "car_setups", func(h common.Header) interface{} {
+		return &car_setups.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarTelemetry, 1}, "car_telemetry", func(h common.Header) interface{} {
+		return &car_telemetry.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarStatus, 1}, "car_status", func(h common.Header) interface{} {
+		return &car_status.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDFinalClassification, 1}, "final_classification", func(h common.Header) interface{} {
+		return &final_classification.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDLobbyInfo, 1}, "lobby_info", func(h common.Header) interface{} {
+		return &lobby_info.Packet{Header: h}
+	})
+}
+
+// register2021 register the F1 2021 packet types
+func register2021(r Registry) {
+	f := common.PacketFormat2021
+	mustRegister(r, Key{f, common.PacketIDMotion, 1}, "motion", func(h common.Header) interface{} {
+		return &motion.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDSession, 1}, "session", func(h common.Header) interface{} {
+		return &session.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDLapData, 1}, "lap_data", func(h common.Header) interface{} {
+		return &lap_data.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDEvent, 1}, "event", func(h common.Header) interface{} {
+		return &event.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDParticipants, 1}, "participants", func(h common.Header) interface{} {
+		return &participants.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarSetups, 1}, "car_setups", func(h common.Header) interface{} {
+		return &car_setups.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarTelemetry, 1}, "car_telemetry", func(h common.Header) interface{} {
+		return &car_telemetry.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarStatus, 1}, "car_status", func(h common.Header) interface{} {
+		return &car_status.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDFinalClassification, 1}, "final_classification", func(h common.Header) interface{} {
+		return &final_classification.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDLobbyInfo, 1}, "lobby_info", func(h common.Header) interface{} {
+		return &lobby_info.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarDamage, 1}, "car_damage", func(h common.Header) interface{} {
+		return &car_damage.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDSessionHistory, 1}, "session_history", func(h common.Header) interface{} {
+		return &session_history.Packet{Header: h}
+	})
+}
+
+// register2022 register the F1 2022 packet types
+func register2022(r Registry) {
+	f := common.PacketFormat2022
+	mustRegister(r, Key{f, common.PacketIDMotion, 1}, "motion", func(h common.Header) interface{} {
+		return &motion.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDSession, 1}, "session", func(h common.Header) interface{} {
+		return &session.Packet2022{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDLapData, 1}, "lap_data", func(h common.Header) interface{} {
+		return &lap_data.Packet2022{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDEvent, 1}, "event", func(h common.Header) interface{} {
+		return &event.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDParticipants, 1}, "participants", func(h common.Header) interface{} {
+		return &participants.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarSetups, 1}, "car_setups", func(h common.Header) interface{} {
+		return &car_setups.Packet{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarTelemetry, 1}, "car_telemetry", func(h common.Header) interface{} {
+		return &car_telemetry.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarStatus, 1}, "car_status", func(h common.Header) interface{} {
+		return &car_status.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDFinalClassification, 1}, "final_classification", func(h common.Header) interface{} {
+		return &final_classification.Packet2022{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDLobbyInfo, 1}, "lobby_info", func(h common.Header) interface{} {
+		return &lobby_info.Packet2021{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDCarDamage, 1}, "car_damage", func(h common.Header) interface{} {
+		return &car_damage.Packet2022{Header: h}
+	})
+	mustRegister(r, Key{f, common.PacketIDSessionHistory, 1}, "session_history", func(h common.Header) interface{} {
+		return &session_history.Packet{Header: h}
+	})
+}
+
+// mustRegisterFormat register a built in packet format, panicking on duplicates as this is a programming error
+func mustRegisterFormat(r Registry, format uint16, header HeaderConstructor) {
+	err := r.RegisterFormat(format, header)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register built in packet format: %v", err))
+	}
+}
+
+// mustRegister register a built in packet type, panicking on duplicates as this is a programming error
+func mustRegister(r Registry, key Key, name string, constructor Constructor) {
+	err := r.Register(key, name, constructor)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register built in packet: %v", err))
+	}
+}
@@ -0,0 +1,20 @@
+package registry
+
+import (
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+)
+
+// NewHeader2019 create an empty F1 2019 header layout
+func NewHeader2019() HeaderLayout {
+	return &common.Header2019{}
+}
+
+// NewHeader2020 create an empty F1 2020 header layout, also used by F1 2021 and F1 2022
+func NewHeader2020() HeaderLayout {
+	return &common.Header{}
+}
+
+// NewHeader2023 create an empty F1 2023 header layout
+func NewHeader2023() HeaderLayout {
+	return &common.Header2023{}
+}
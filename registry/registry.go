@@ -0,0 +1,306 @@
+package registry
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/roryphillips/f1-telemetry-client/packets"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"sync"
+)
+
+// ErrUnregisteredPacket returned when decoding a packet with no registered type
+var ErrUnregisteredPacket = errors.New("no packet type registered")
+
+// ErrUnexpectedSize returned in strict mode when a packet is not the size of its registered type
+var ErrUnexpectedSize = errors.New("unexpected packet size")
+
+// ErrUnsupportedFormat returned when decoding a packet from a game with no registered header layout
+var ErrUnsupportedFormat = errors.New("unsupported packet format")
+
+// ErrInvalidHeader returned when the header of a packet can't be decoded, i.e. the packet is too short
+var ErrInvalidHeader = errors.New("failed to decode header")
+
+// Key identifies a packet layout on the wire
+type Key struct {
+	// PacketFormat game the packet was sent by, i.e. 2020
+	PacketFormat uint16
+	// PacketID type of the packet
+	PacketID common.PacketID
+	// PacketVersion version of the packet type
+	PacketVersion uint8
+}
+
+// KeyFor get the key for the packet a header belongs to
+func KeyFor(header common.Header) Key {
+	return Key{
+		PacketFormat:  header.PacketFormat,
+		PacketID:      header.PacketID,
+		PacketVersion: header.PacketVersion,
+	}
+}
+
+// Constructor creates an empty packet, as a ptr to a struct, for the given header to be decoded into
+type Constructor func(header common.Header) interface{}
+
+// HeaderLayout header layout used by a packet format, converted into the common header once decoded
+// and set from the common header before being encoded
+type HeaderLayout interface {
+	Header() common.Header
+	SetHeader(header common.Header)
+}
+
+// HeaderConstructor creates an empty header layout, as a ptr to a struct, to be decoded into
+type HeaderConstructor func() HeaderLayout
+
+// Entry registered packet type
+type Entry struct {
+	// Name short name for the packet type, i.e. "motion"
+	Name string
+	// New constructor for the packet type
+	New Constructor
+}
+
+// Options how a registry decodes packets
+type Options struct {
+	// Reflective always decode using the PacketParser, even if a generated decoder is available
+	Reflective bool
+	// Strict reject packets which are shorter or longer than the size of their registered type
+	Strict bool
+}
+
+// Registry maps packet keys to the packet types able to decode them
+type Registry interface {
+	// RegisterFormat register the header layout for a packet format, fails if the format is already registered
+	RegisterFormat(format uint16, header HeaderConstructor) error
+	// Register a packet type for a key, fails if the key is already registered
+	Register(key Key, name string, constructor Constructor) error
+	// Lookup the packet type registered for a header
+	Lookup(header common.Header) (Entry, bool)
+	// Decode the header and the registered packet type from the raw packet bytes
+	Decode(data []byte) (common.Header, interface{}, error)
+	// DecodeHeader decode only the header from the raw packet bytes, i.e. to route packets without decoding them
+	DecodeHeader(data []byte) (common.Header, error)
+	// Encode the header, in the layout of its packet format, followed by the packet into raw packet bytes
+	Encode(header common.Header, packet interface{}) ([]byte, error)
+}
+
+type registry struct {
+	parser  packets.PacketParser
+	writer  packets.PacketWriter
+	options Options
+	formats map[uint16]HeaderConstructor
+	entries map[Key]Entry
+	lock    *sync.RWMutex
+}
+
+// New creates an empty registry which decodes packets using generated decoders,
+// falling back to the given parser for packet types without one
+func New(parser packets.PacketParser, options Options) Registry {
+	return &registry{
+		parser:  parser,
+		writer:  packets.NewPacketWriter(),
+		options: options,
+		formats: make(map[uint16]HeaderConstructor),
+		entries: make(map[Key]Entry),
+		lock:    &sync.RWMutex{},
+	}
+}
+
+// RegisterFormat register the header layout for a packet format, fails if the format is already registered
+func (r *registry) RegisterFormat(format uint16, header HeaderConstructor) error {
+	if header == nil {
+		return fmt.Errorf("header constructor for %v must not be nil", format)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.formats[format]; ok {
+		return fmt.Errorf("packet format %v already registered", format)
+	}
+	r.formats[format] = header
+	return nil
+}
+
+// Register a packet type for a key, fails if the key is already registered
+func (r *registry) Register(key Key, name string, constructor Constructor) error {
+	if constructor == nil {
+		return fmt.Errorf("constructor for %v must not be nil", name)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if existing, ok := r.entries[key]; ok {
+		return fmt.Errorf("packet %+v already registered as %v", key, existing.Name)
+	}
+	r.entries[key] = Entry{
+		Name: name,
+		New:  constructor,
+	}
+	return nil
+}
+
+// Lookup the packet type registered for a header
+func (r *registry) Lookup(header common.Header) (Entry, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	entry, ok := r.entries[KeyFor(header)]
+	return entry, ok
+}
+
+// headerLayout an empty header layout for the packet format of the raw packet bytes
+func (r *registry) headerLayout(data []byte) (HeaderLayout, error) {
+	if len(data) < 2 {
+		return nil, fmt.Errorf("%w: packet of %v bytes is too short to contain a header", ErrInvalidHeader, len(data))
+	}
+	// The packet format is always the first field, regardless of the header layout
+	format := binary.LittleEndian.Uint16(data)
+
+	r.lock.RLock()
+	newHeader, ok := r.formats[format]
+	r.lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %v", ErrUnsupportedFormat, format)
+	}
+	return newHeader(), nil
+}
+
+// Decode the header and the registered packet type from the raw packet bytes
+func (r *registry) Decode(data []byte) (common.Header, interface{}, error) {
+	var header common.Header
+
+	layout, err := r.headerLayout(data)
+	if err != nil {
+		return header, nil, err
+	}
+	headerDecoder, generated := layout.(packets.PacketDecoder)
+	if r.options.Reflective || !generated {
+		return r.decodeReflective(packets.NewPacket(data), layout)
+	}
+
+	n, err := headerDecoder.DecodeFrom(data)
+	if err != nil {
+		return header, nil, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+	header = layout.Header()
+
+	entry, dest, err := r.newPacket(header)
+	if err != nil {
+		return header, nil, err
+	}
+	err = r.checkSize(header, layout, dest, len(data))
+	if err != nil {
+		return header, nil, err
+	}
+
+	if decoder, ok := dest.(packets.PacketDecoder); ok {
+		_, err = decoder.DecodeFrom(data[n:])
+	} else {
+		err = r.parser.Parse(packets.NewPacket(data[n:]), dest)
+	}
+	if err != nil {
+		return header, nil, fmt.Errorf("failed to decode %v packet: %v", entry.Name, err)
+	}
+
+	return header, dest, nil
+}
+
+// DecodeHeader decode only the header from the raw packet bytes, i.e. to route packets without decoding them
+func (r *registry) DecodeHeader(data []byte) (common.Header, error) {
+	layout, err := r.headerLayout(data)
+	if err != nil {
+		return common.Header{}, err
+	}
+
+	if decoder, ok := layout.(packets.PacketDecoder); ok && !r.options.Reflective {
+		_, err = decoder.DecodeFrom(data)
+	} else {
+		err = r.parser.Parse(packets.NewPacket(data), layout)
+	}
+	if err != nil {
+		return common.Header{}, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+	return layout.Header(), nil
+}
+
+// Encode the header, in the layout of its packet format, followed by the packet into raw packet bytes
+func (r *registry) Encode(header common.Header, packet interface{}) ([]byte, error) {
+	r.lock.RLock()
+	newHeader, ok := r.formats[header.PacketFormat]
+	r.lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %v", ErrUnsupportedFormat, header.PacketFormat)
+	}
+
+	layout := newHeader()
+	layout.SetHeader(header)
+	out, err := r.writer.Encode(layout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode header: %v", err)
+	}
+
+	body, err := r.writer.Encode(packet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode packet: %v", err)
+	}
+
+	return append(out, body...), nil
+}
+
+// decodeReflective decode the header and packet using the PacketParser
+func (r *registry) decodeReflective(packet packets.Packet, layout HeaderLayout) (common.Header, interface{}, error) {
+	err := r.parser.Parse(packet, layout)
+	if err != nil {
+		return common.Header{}, nil, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+	header := layout.Header()
+
+	entry, dest, err := r.newPacket(header)
+	if err != nil {
+		return header, nil, err
+	}
+	err = r.checkSize(header, layout, dest, packet.Offset()+packet.Remaining())
+	if err != nil {
+		return header, nil, err
+	}
+
+	err = r.parser.Parse(packet, dest)
+	if err != nil {
+		return header, nil, fmt.Errorf("failed to parse %v packet: %v", entry.Name, err)
+	}
+
+	return header, dest, nil
+}
+
+// checkSize in strict mode, check the packet is exactly the size of its header layout and registered type
+func (r *registry) checkSize(header common.Header, layout HeaderLayout, dest interface{}, actual int) error {
+	if !r.options.Strict {
+		return nil
+	}
+
+	headerSize, err := packets.SizeOf(layout)
+	if err != nil {
+		return fmt.Errorf("unable to size header: %v", err)
+	}
+	packetSize, err := packets.SizeOf(dest)
+	if err != nil {
+		return fmt.Errorf("unable to size packet %v: %v", header.PacketID, err)
+	}
+
+	if expected := headerSize + packetSize; actual != expected {
+		return fmt.Errorf("%w for packet %v: expected %v bytes, got %v", ErrUnexpectedSize, header.PacketID, expected, actual)
+	}
+	return nil
+}
+
+// newPacket create the empty packet registered for a header
+func (r *registry) newPacket(header common.Header) (Entry, interface{}, error) {
+	entry, ok := r.Lookup(header)
+	if !ok {
+		return entry, nil, fmt.Errorf("%w for %+v", ErrUnregisteredPacket, KeyFor(header))
+	}
+	return entry, entry.New(header), nil
+}
@@ -0,0 +1,128 @@
+package registry_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/roryphillips/f1-telemetry-client/packets/common"
+	"github.com/roryphillips/f1-telemetry-client/packets/event"
+	"github.com/roryphillips/f1-telemetry-client/packets/packettest"
+	"github.com/roryphillips/f1-telemetry-client/registry"
+	"testing"
+)
+
+// rawPacket a packet of a format, type and total size on the wire, with an all zero body after the event code
+func rawPacket(format uint16, id common.PacketID, code event.EventCode, size int) []byte {
+	data := make([]byte, size)
+	binary.LittleEndian.PutUint16(data, format)
+	// The packet version and ID are at the same offsets in the 2019 and 2020 header layouts
+	data[4] = 1
+	data[5] = uint8(id)
+	headerSize := (&common.Header{}).PacketSize()
+	if format <= common.PacketFormat2019 {
+		headerSize = (&common.Header2019{}).PacketSize()
+	}
+	if code != "" && size >= headerSize+4 {
+		copy(data[headerSize:], code)
+	}
+	return data
+}
+
+// sizes packets of known sizes, taken from the game's documentation
+var sizes = []struct {
+	name   string
+	format uint16
+	id     common.PacketID
+	code   event.EventCode
+	size   int
+	// padding bytes at the end of the packet which aren't part of its layout
+	padding int
+}{
+	{"2019 motion", 2019, common.PacketIDMotion, "", 1343, 0},
+	{"2020 motion", 2020, common.PacketIDMotion, "", 1464, 0},
+	{"2020 session", 2020, common.PacketIDSession, "", 251, 0},
+	{"2021 lap data", 2021, common.PacketIDLapData, "", 970, 0},
+	{"2021 car damage", 2021, common.PacketIDCarDamage, "", 882, 0},
+	{"2022 session", 2022, common.PacketIDSession, "", 632, 0},
+	{"2022 final classification", 2022, common.PacketIDFinalClassification, "", 1015, 0},
+	// The event packet is padded to the size of the largest event in each format
+	{"2019 fastest lap event", 2019, common.PacketIDEvent, event.EventCodeFastestLap, 32, 0},
+	{"2020 fastest lap event", 2020, common.PacketIDEvent, event.EventCodeFastestLap, 35, 2},
+	{"2021 session started event", 2021, common.PacketIDEvent, event.EventCodeSessionStarted, 36, 8},
+	{"2021 speed trap event", 2021, common.PacketIDEvent, event.EventCodeSpeedTrap, 36, 1},
+	{"2022 penalty event", 2022, common.PacketIDEvent, event.EventCodePenalty, 40, 5},
+}
+
+func TestStrict(t *testing.T) {
+	for _, options := range []registry.Options{{Strict: true}, {Strict: true, Reflective: true}} {
+		r := registry.NewDefault(options)
+		for _, test := range sizes {
+			_, packet, err := r.Decode(rawPacket(test.format, test.id, test.code, test.size))
+			if err != nil || packet == nil {
+				t.Errorf("%+v: %v: failed to decode %v bytes: %v", options, test.name, test.size, err)
+			}
+
+			for _, size := range []int{test.size - 1, test.size + 1} {
+				_, _, err := r.Decode(rawPacket(test.format, test.id, test.code, size))
+				if !errors.Is(err, registry.ErrUnexpectedSize) {
+					t.Errorf("%+v: %v: decoding %v bytes returned %v, want %v", options, test.name, size, err, registry.ErrUnexpectedSize)
+				}
+			}
+		}
+	}
+}
+
+func TestNotStrict(t *testing.T) {
+	for _, options := range []registry.Options{{}, {Reflective: true}} {
+		r := registry.NewDefault(options)
+		for _, test := range sizes {
+			for _, size := range []int{test.size, test.size + 1} {
+				_, packet, err := r.Decode(rawPacket(test.format, test.id, test.code, size))
+				if err != nil || packet == nil {
+					t.Errorf("%+v: %v: failed to decode %v bytes: %v", options, test.name, size, err)
+				}
+			}
+
+			// A packet too short for its layout can't be decoded at all, unless it's only padding which is missing
+			_, packet, err := r.Decode(rawPacket(test.format, test.id, test.code, test.size-1))
+			if errors.Is(err, registry.ErrUnexpectedSize) {
+				t.Errorf("%+v: %v: decoding %v bytes returned %v when not strict", options, test.name, test.size-1, err)
+			}
+			if test.padding == 0 && err == nil {
+				t.Errorf("%+v: %v: decoded %v bytes, too few for the layout", options, test.name, test.size-1)
+			}
+			if test.padding > 0 && (err != nil || packet == nil) {
+				t.Errorf("%+v: %v: failed to decode %v bytes without the last byte of padding: %v", options, test.name, test.size-1, err)
+			}
+		}
+	}
+}
+
+// TestDecodePaths the generated decoders and the reflective parser decode the same packets
+func TestDecodePaths(t *testing.T) {
+	generated := registry.NewDefault(registry.Options{Strict: true})
+	reflective := registry.NewDefault(registry.Options{Strict: true, Reflective: true})
+	for _, test := range sizes {
+		data := rawPacket(test.format, test.id, test.code, test.size)
+		// Something other than zeros at the end of the body, which every layout can still decode,
+		// leaving the header and any event code alone
+		for i := len(data) - 1; i > len(data)-20 && i >= 28; i-- {
+			data[i] = 1
+		}
+		generatedHeader, generatedPacket, err := generated.Decode(data)
+		if err != nil {
+			t.Errorf("%v: failed to decode with generated decoders: %v", test.name, err)
+			continue
+		}
+		reflectiveHeader, reflectivePacket, err := reflective.Decode(data)
+		if err != nil {
+			t.Errorf("%v: failed to decode with the reflective parser: %v", test.name, err)
+			continue
+		}
+		if generatedHeader != reflectiveHeader {
+			t.Errorf("%v: generated decoders read header %+v, reflective parser read %+v", test.name, generatedHeader, reflectiveHeader)
+		}
+		if !packettest.Equal(generatedPacket, reflectivePacket) {
+			t.Errorf("%v: generated decoders read %+v, reflective parser read %+v", test.name, generatedPacket, reflectivePacket)
+		}
+	}
+}